@@ -58,11 +58,11 @@ func BenchmarkEvolutionOneMaxMultiSolver(b *testing.B) {
 
 		for j := 0; j < multiSolverCount; j++ {
 			wg.Add(1)
-			go func(prob problem.IProblem) {
+			go func(prob problem.IProblem, stream int64) {
 
 				defer wg.Done()
 
-				prob.SetGenerator(rng.Clone(time.Now().UnixNano()))
+				prob.SetGenerator(generator.Derive(rng, stream))
 
 				s := solver.NewSolver()
 				s.SetEpochs(epochs)
@@ -70,7 +70,7 @@ func BenchmarkEvolutionOneMaxMultiSolver(b *testing.B) {
 				s.SetPopulationSize(populationSize)
 
 				_ = s.Run()
-			}(p)
+			}(p, int64(j))
 		}
 		wg.Wait()
 	}
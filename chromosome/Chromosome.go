@@ -9,6 +9,7 @@ import (
 // IChromosome interface.
 type Chromosome struct {
 	fitness    float64
+	fitnesses  []float64
 	dimensions int
 	generator  generator.IGenerator
 	IChromosome
@@ -18,9 +19,21 @@ type Chromosome struct {
 // SETTERS ////////////////////////////////////////////////////////////////////
 ///////////////////////////////////////////////////////////////////////////////
 
-// SetFitness sets the fitness of the chromosome
+// SetFitness sets the fitness of the chromosome, which also becomes its only
+// fitness so that GetFitnesses never returns those of an earlier evaluation
 func (c *Chromosome) SetFitness(fitness float64) {
 	c.fitness = fitness
+	c.fitnesses = nil
+}
+
+// SetFitnesses sets the fitness of every objective of a multi-objective
+// problem. The fitness of the chromosome becomes that of the first objective
+// so that single objective behaviours, such as sorting, follow it.
+func (c *Chromosome) SetFitnesses(fitnesses []float64) {
+	c.fitnesses = fitnesses
+	if len(fitnesses) > 0 {
+		c.fitness = fitnesses[0]
+	}
 }
 
 // SetGenerator sets the generator of the chromosome
@@ -42,6 +55,15 @@ func (c *Chromosome) GetFitness() float64 {
 	return c.fitness
 }
 
+// GetFitnesses returns the fitness of every objective, or the fitness alone
+// when the chromosome was evaluated against a single objective.
+func (c *Chromosome) GetFitnesses() []float64 {
+	if len(c.fitnesses) == 0 {
+		return []float64{c.fitness}
+	}
+	return c.fitnesses
+}
+
 // GetDimensions returns the number of dimensions of the chromosome.
 func (c *Chromosome) GetDimensions() int {
 	return c.dimensions
@@ -1,5 +1,11 @@
 package chromosome
 
+import (
+	"sort"
+
+	"github.com/opticverge/goevolution/objective"
+)
+
 // Chromosomes represents a list of IChromosomes. This allows us to tap into
 // the sort api in go.
 type Chromosomes []IChromosome
@@ -15,3 +21,12 @@ func (c Chromosomes) Swap(i, j int) {
 func (c Chromosomes) Less(i, j int) bool {
 	return c[i].GetFitness() < c[j].GetFitness()
 }
+
+// Sort orders the chromosomes from best to worst according to the objective.
+func (c Chromosomes) Sort(obj objective.Objective) {
+	sort.Sort(c)
+
+	if obj == objective.Maximisation {
+		sort.Sort(sort.Reverse(c))
+	}
+}
@@ -19,10 +19,12 @@ type IChromosome interface {
 	// Generic functions to be implemented by the base Chromosome
 	// struct that will be embedded into all Chromosome variants
 	SetFitness(float64)
+	SetFitnesses([]float64)
 	SetGenerator(generator.IGenerator)
 	SetDimensions(int)
 
 	GetFitness() float64
+	GetFitnesses() []float64
 	GetDimensions() int
 	GetGenerator() generator.IGenerator
 	GetPhenotype() interface{}
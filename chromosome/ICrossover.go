@@ -0,0 +1,14 @@
+package chromosome
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// ICrossover is the interface for chromosomes which are able to recombine
+// their genetic material with another chromosome. A chromosome that embeds
+// the base Chromosome struct can implement Crossover to take part in the
+// crossover stage of the solver. The children returned must be new
+// chromosomes, leaving both parents untouched.
+type ICrossover interface {
+	Crossover(IChromosome, generator.IGenerator) []IChromosome
+}
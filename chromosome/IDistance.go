@@ -0,0 +1,8 @@
+package chromosome
+
+// IDistance is the interface for chromosomes which can measure how far their
+// genotype is from another chromosome of the same kind. It is used to
+// measure the diversity of a population.
+type IDistance interface {
+	Distance(IChromosome) float64
+}
@@ -0,0 +1,10 @@
+package chromosome
+
+// IVariableLength is the interface for chromosomes whose dimensions change
+// under variation, such as by inserting or deleting genes. GetDimensions
+// must report the current length of the chromosome. The solver calls Resize
+// on chromosomes which leave its length limits, which should truncate or
+// extend the genes to exactly the dimensions given.
+type IVariableLength interface {
+	Resize(dimensions int)
+}
@@ -0,0 +1,37 @@
+package binary
+
+import (
+	"math"
+
+	"github.com/opticverge/goevolution/generator"
+)
+
+// BitFlipMutation inverts every bit independently with the mutation
+// probability. Rather than drawing a random number for every bit it draws
+// the gap to the next flipped bit from the geometric distribution, which
+// makes low mutation probabilities cheap on long bit strings.
+func BitFlipMutation(b *Bits, probability float64, rng generator.IGenerator) {
+
+	if probability <= 0 {
+		return
+	}
+
+	if probability >= 1 {
+		for i := 0; i < b.Len(); i++ {
+			b.Flip(i)
+		}
+		return
+	}
+
+	scale := 1 / math.Log(1-probability)
+
+	for i := -1; ; {
+		// the number of bits to skip before the next flip
+		gap := math.Floor(math.Log(1-rng.Float64()) * scale)
+		if gap >= float64(b.Len()-i-1) {
+			return
+		}
+		i += int(gap) + 1
+		b.Flip(i)
+	}
+}
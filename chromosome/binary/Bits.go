@@ -0,0 +1,139 @@
+package binary
+
+import (
+	"math/bits"
+	"strings"
+
+	"github.com/opticverge/goevolution/generator"
+)
+
+// wordSize is the number of bits packed into each word
+const wordSize = 64
+
+// Bits is a fixed length string of bits packed 64 to a word. Bits beyond the
+// length in the last word are always zero.
+type Bits struct {
+	words  []uint64
+	length int
+}
+
+// Len returns the number of bits
+func (b *Bits) Len() int {
+	return b.length
+}
+
+// Words returns the packed words of the bits
+func (b *Bits) Words() []uint64 {
+	return b.words
+}
+
+// Get returns the bit at the position
+func (b *Bits) Get(i int) bool {
+	return b.words[i/wordSize]&(1<<uint(i%wordSize)) != 0
+}
+
+// Set sets the bit at the position
+func (b *Bits) Set(i int, value bool) {
+	if value {
+		b.words[i/wordSize] |= 1 << uint(i%wordSize)
+	} else {
+		b.words[i/wordSize] &^= 1 << uint(i%wordSize)
+	}
+}
+
+// Flip inverts the bit at the position
+func (b *Bits) Flip(i int) {
+	b.words[i/wordSize] ^= 1 << uint(i%wordSize)
+}
+
+// Count returns the number of set bits
+func (b *Bits) Count() int {
+	count := 0
+	for _, word := range b.words {
+		count += bits.OnesCount64(word)
+	}
+	return count
+}
+
+// Hamming returns the number of positions at which the bits differ from the
+// other bits of the same length
+func (b *Bits) Hamming(other *Bits) int {
+	distance := 0
+	for i, word := range b.words {
+		distance += bits.OnesCount64(word ^ other.words[i])
+	}
+	return distance
+}
+
+// Clone returns a copy of the bits
+func (b *Bits) Clone() *Bits {
+	return &Bits{words: append([]uint64(nil), b.words...), length: b.length}
+}
+
+// Randomise sets every bit to a uniformly random value
+func (b *Bits) Randomise(rng generator.IGenerator) {
+	for i := range b.words {
+		b.words[i] = RandomWord(rng)
+	}
+	b.trim()
+}
+
+// String returns the bits as a string of zeros and ones
+func (b *Bits) String() string {
+	var builder strings.Builder
+	builder.Grow(b.length)
+	for i := 0; i < b.length; i++ {
+		if b.Get(i) {
+			builder.WriteByte('1')
+		} else {
+			builder.WriteByte('0')
+		}
+	}
+	return builder.String()
+}
+
+// trim clears the unused bits of the last word
+func (b *Bits) trim() {
+	if remainder := b.length % wordSize; remainder != 0 {
+		b.words[len(b.words)-1] &= (1 << uint(remainder)) - 1
+	}
+}
+
+// SwapRange exchanges the bits in the range [from, to) between two bit
+// strings of the same length
+func SwapRange(a *Bits, b *Bits, from int, to int) {
+	for from < to {
+		word := from / wordSize
+		offset := uint(from % wordSize)
+
+		// build a mask for the bits of this word within the range
+		span := wordSize - int(offset)
+		if to-from < span {
+			span = to - from
+		}
+		mask := ^uint64(0)
+		if span < wordSize {
+			mask = ((1 << uint(span)) - 1) << offset
+		}
+
+		diff := (a.words[word] ^ b.words[word]) & mask
+		a.words[word] ^= diff
+		b.words[word] ^= diff
+
+		from += span
+	}
+}
+
+// RandomWord returns 64 uniformly random bits, drawn in chunks of 30 bits so
+// that every draw fits an int on 32-bit targets
+func RandomWord(rng generator.IGenerator) uint64 {
+	return uint64(rng.Intn(1<<30))<<34 | uint64(rng.Intn(1<<30))<<4 | uint64(rng.Intn(1<<4))
+}
+
+// NewBits creates a new string of bits which are all zero
+func NewBits(length int) *Bits {
+	return &Bits{
+		words:  make([]uint64, (length+wordSize-1)/wordSize),
+		length: length,
+	}
+}
@@ -0,0 +1,94 @@
+package binary
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/generator"
+)
+
+// Chromosome represents a string of bits packed 64 to a word, which keeps
+// chromosomes of tens of thousands of bits small and cheap to clone. The
+// crossover operator is shared by every clone and child of the chromosome.
+type Chromosome struct {
+	chromosome.Chromosome
+	Phenotype *Bits
+	crossover ICrossover
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// SETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// SetCrossover sets the crossover operator of the chromosome
+func (c *Chromosome) SetCrossover(crossover ICrossover) {
+	c.crossover = crossover
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// GETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// GetPhenotype returns the bits of the chromosome
+func (c *Chromosome) GetPhenotype() interface{} {
+	return c.Phenotype
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// ICHROMOSOME IMPLEMENTATIONS ////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// Generate creates a uniformly random string of bits
+func (c *Chromosome) Generate() {
+	c.Phenotype = NewBits(c.GetDimensions())
+	c.Phenotype.Randomise(c.GetGenerator())
+}
+
+// Mutate flips every bit with the mutation probability
+func (c *Chromosome) Mutate(mutationProbability float64) {
+	BitFlipMutation(c.Phenotype, mutationProbability, c.GetGenerator())
+}
+
+// Clone creates a new copy of the chromosome
+func (c *Chromosome) Clone(rng generator.IGenerator) chromosome.IChromosome {
+	return c.with(c.Phenotype.Clone(), rng)
+}
+
+// Crossover applies the crossover operator with the other chromosome and
+// returns the children
+func (c *Chromosome) Crossover(other chromosome.IChromosome, rng generator.IGenerator) []chromosome.IChromosome {
+
+	phenotypes := c.crossover.Crossover(c.Phenotype, other.(*Chromosome).Phenotype, rng)
+
+	children := make([]chromosome.IChromosome, len(phenotypes))
+	for i, phenotype := range phenotypes {
+		children[i] = c.with(phenotype, rng)
+	}
+
+	return children
+}
+
+// Distance returns the hamming distance to the other chromosome
+func (c *Chromosome) Distance(other chromosome.IChromosome) float64 {
+	return float64(c.Phenotype.Hamming(other.(*Chromosome).Phenotype))
+}
+
+// with creates a chromosome with the same configuration holding the bits
+func (c *Chromosome) with(phenotype *Bits, rng generator.IGenerator) *Chromosome {
+	chr := NewChromosome(c.GetDimensions(), rng).(*Chromosome)
+	chr.SetCrossover(c.crossover)
+	chr.Phenotype = phenotype
+	return chr
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// CONSTRUCTOR ////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// NewChromosome creates a new instance of a binary Chromosome using one
+// point crossover by default
+func NewChromosome(dimensions int, rng generator.IGenerator) chromosome.IChromosome {
+	chr := &Chromosome{}
+	chr.SetGenerator(rng)
+	chr.SetDimensions(dimensions)
+	chr.SetCrossover(NewOnePointCrossover())
+	return chr
+}
@@ -0,0 +1,11 @@
+package binary
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// ICrossover represents the interface for crossovers of bit strings. The
+// children returned are new bit strings of the same length as the parents.
+type ICrossover interface {
+	Crossover(first *Bits, second *Bits, rng generator.IGenerator) []*Bits
+}
@@ -0,0 +1,22 @@
+package binary
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// OnePointCrossover exchanges the tails of the parents after a random point
+type OnePointCrossover struct{}
+
+// Crossover produces two children from the parents
+func (o *OnePointCrossover) Crossover(first *Bits, second *Bits, rng generator.IGenerator) []*Bits {
+	left, right := first.Clone(), second.Clone()
+	if first.Len() > 1 {
+		SwapRange(left, right, 1+rng.Intn(first.Len()-1), first.Len())
+	}
+	return []*Bits{left, right}
+}
+
+// NewOnePointCrossover creates a new instance of the OnePointCrossover
+func NewOnePointCrossover() ICrossover {
+	return &OnePointCrossover{}
+}
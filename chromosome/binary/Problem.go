@@ -0,0 +1,30 @@
+package binary
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/problem"
+)
+
+// Problem is the base struct for problems over strings of bits. It generates
+// chromosomes with its crossover operator so that a new problem which embeds
+// it only needs to implement the ObjectiveFunction.
+type Problem struct {
+	problem.Problem
+	crossover ICrossover
+}
+
+// SetCrossover sets the crossover operator given to generated chromosomes
+func (p *Problem) SetCrossover(crossover ICrossover) {
+	p.crossover = crossover
+}
+
+// GenerateChromosome creates a new binary Chromosome. The chromosome is given
+// its own generator derived from the problem, which the solver replaces with
+// one derived for the position of the chromosome in the run.
+func (p *Problem) GenerateChromosome() chromosome.IChromosome {
+	chr := NewChromosome(p.GetDimensions(), p.DeriveGenerator()).(*Chromosome)
+	if p.crossover != nil {
+		chr.SetCrossover(p.crossover)
+	}
+	return chr
+}
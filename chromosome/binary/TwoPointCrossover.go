@@ -0,0 +1,27 @@
+package binary
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// TwoPointCrossover exchanges the bits of the parents between two random
+// points
+type TwoPointCrossover struct{}
+
+// Crossover produces two children from the parents
+func (t *TwoPointCrossover) Crossover(first *Bits, second *Bits, rng generator.IGenerator) []*Bits {
+	left, right := first.Clone(), second.Clone()
+	if first.Len() > 1 {
+		from, to := rng.Intn(first.Len()), rng.Intn(first.Len())
+		if from > to {
+			from, to = to, from
+		}
+		SwapRange(left, right, from, to+1)
+	}
+	return []*Bits{left, right}
+}
+
+// NewTwoPointCrossover creates a new instance of the TwoPointCrossover
+func NewTwoPointCrossover() ICrossover {
+	return &TwoPointCrossover{}
+}
@@ -0,0 +1,25 @@
+package binary
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// UniformCrossover exchanges every bit of the parents with a probability of
+// one half, a whole word at a time
+type UniformCrossover struct{}
+
+// Crossover produces two children from the parents
+func (u *UniformCrossover) Crossover(first *Bits, second *Bits, rng generator.IGenerator) []*Bits {
+	left, right := first.Clone(), second.Clone()
+	for i := range left.words {
+		diff := (left.words[i] ^ right.words[i]) & RandomWord(rng)
+		left.words[i] ^= diff
+		right.words[i] ^= diff
+	}
+	return []*Bits{left, right}
+}
+
+// NewUniformCrossover creates a new instance of the UniformCrossover
+func NewUniformCrossover() ICrossover {
+	return &UniformCrossover{}
+}
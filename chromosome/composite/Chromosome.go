@@ -0,0 +1,139 @@
+package composite
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/generator"
+)
+
+// Chromosome represents a chromosome made of named parts of different kinds,
+// such as a real valued learning rate, an integer layer count and a binary
+// feature mask. Generation, mutation, cloning and crossover are delegated to
+// the chromosome of each part, each with a generator derived from the
+// position of the part. The dimensions of the chromosome are its number of
+// parts.
+type Chromosome struct {
+	chromosome.Chromosome
+	Parts  []chromosome.IChromosome
+	layout []*Part
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// GETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// GetLayout returns the description of every part
+func (c *Chromosome) GetLayout() []*Part {
+	return c.layout
+}
+
+// Get returns the chromosome of the named part or nil when there is no part
+// with the name
+func (c *Chromosome) Get(name string) chromosome.IChromosome {
+	for i, part := range c.layout {
+		if part.Name == name {
+			return c.Parts[i]
+		}
+	}
+	return nil
+}
+
+// GetPhenotype returns the phenotype of every part keyed by its name
+func (c *Chromosome) GetPhenotype() interface{} {
+	phenotype := make(map[string]interface{}, len(c.layout))
+	for i, part := range c.layout {
+		phenotype[part.Name] = c.Parts[i].GetPhenotype()
+	}
+	return phenotype
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// ICHROMOSOME IMPLEMENTATIONS ////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// Generate creates and generates the chromosome of every part
+func (c *Chromosome) Generate() {
+	c.Parts = make([]chromosome.IChromosome, len(c.layout))
+	for i, part := range c.layout {
+		c.Parts[i] = part.New(generator.Derive(c.GetGenerator(), int64(i)))
+		c.Parts[i].Generate()
+	}
+}
+
+// Mutate mutates every part according to its mutation rate
+func (c *Chromosome) Mutate(mutationProbability float64) {
+	for i, part := range c.layout {
+		if c.GetGenerator().Float64() < part.MutationRate {
+			c.Parts[i].Mutate(mutationProbability)
+		}
+	}
+}
+
+// Clone creates a new copy of the chromosome
+func (c *Chromosome) Clone(rng generator.IGenerator) chromosome.IChromosome {
+	parts := make([]chromosome.IChromosome, len(c.Parts))
+	for i, part := range c.Parts {
+		parts[i] = part.Clone(generator.Derive(rng, int64(i)))
+	}
+	return c.with(parts, rng)
+}
+
+// Crossover recombines every part with the same part of the other chromosome
+// according to its crossover rate and returns two children. Parts that do
+// not implement ICrossover, or are not recombined, are inherited from one
+// parent by each child.
+func (c *Chromosome) Crossover(other chromosome.IChromosome, rng generator.IGenerator) []chromosome.IChromosome {
+
+	mate := other.(*Chromosome)
+	left := make([]chromosome.IChromosome, len(c.Parts))
+	right := make([]chromosome.IChromosome, len(c.Parts))
+
+	for i, part := range c.layout {
+		partGenerator := generator.Derive(rng, int64(i))
+
+		if recombinable, ok := c.Parts[i].(chromosome.ICrossover); ok && rng.Float64() < part.CrossoverRate {
+			children := recombinable.Crossover(mate.Parts[i], partGenerator)
+			if len(children) >= 2 {
+				left[i], right[i] = children[0], children[1]
+				continue
+			}
+		}
+
+		left[i] = c.Parts[i].Clone(partGenerator)
+		right[i] = mate.Parts[i].Clone(generator.Derive(partGenerator))
+	}
+
+	return []chromosome.IChromosome{c.with(left, rng), c.with(right, rng)}
+}
+
+// Distance returns the sum of the distances of the parts which implement
+// IDistance
+func (c *Chromosome) Distance(other chromosome.IChromosome) float64 {
+	mate := other.(*Chromosome)
+	distance := 0.0
+	for i, part := range c.Parts {
+		if measurable, ok := part.(chromosome.IDistance); ok {
+			distance += measurable.Distance(mate.Parts[i])
+		}
+	}
+	return distance
+}
+
+// with creates a chromosome with the same layout holding the parts
+func (c *Chromosome) with(parts []chromosome.IChromosome, rng generator.IGenerator) *Chromosome {
+	chr := NewChromosome(rng, c.layout).(*Chromosome)
+	chr.Parts = parts
+	return chr
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// CONSTRUCTOR ////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// NewChromosome creates a new instance of a composite Chromosome with the
+// layout of parts
+func NewChromosome(rng generator.IGenerator, layout []*Part) chromosome.IChromosome {
+	chr := &Chromosome{layout: layout}
+	chr.SetGenerator(rng)
+	chr.SetDimensions(len(layout))
+	return chr
+}
@@ -0,0 +1,29 @@
+package composite
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/generator"
+)
+
+// Part describes one named part of a composite chromosome. New creates the
+// chromosome of the part, configured with its dimensions and operators,
+// before it is generated. The mutation rate is the probability that the part
+// is mutated when the composite is mutated, and the crossover rate is the
+// probability that the part is recombined when the composite is, otherwise
+// each child inherits the part of one parent.
+type Part struct {
+	Name          string
+	MutationRate  float64
+	CrossoverRate float64
+	New           func(rng generator.IGenerator) chromosome.IChromosome
+}
+
+// NewPart creates a new Part which is always mutated and recombined
+func NewPart(name string, new func(rng generator.IGenerator) chromosome.IChromosome) *Part {
+	return &Part{
+		Name:          name,
+		MutationRate:  1,
+		CrossoverRate: 1,
+		New:           new,
+	}
+}
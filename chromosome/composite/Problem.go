@@ -0,0 +1,31 @@
+package composite
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/problem"
+)
+
+// Problem is the base struct for problems over composite chromosomes. Parts
+// are added in the order they appear in the chromosome so that a new problem
+// which embeds it only needs to implement the ObjectiveFunction.
+type Problem struct {
+	problem.Problem
+	layout []*Part
+}
+
+// AddPart appends a part to the layout of generated chromosomes, which also
+// increases the dimensions of the problem
+func (p *Problem) AddPart(part *Part) {
+	p.layout = append(p.layout, part)
+	p.SetDimensions(len(p.layout))
+}
+
+// GetLayout returns the description of every part
+func (p *Problem) GetLayout() []*Part {
+	return p.layout
+}
+
+// GenerateChromosome creates a new composite Chromosome
+func (p *Problem) GenerateChromosome() chromosome.IChromosome {
+	return NewChromosome(p.DeriveGenerator(), p.layout)
+}
@@ -0,0 +1,127 @@
+package integer
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/generator"
+)
+
+// Chromosome represents a vector of integers where every gene lies within
+// its own range, which suits genes that encode categorical or ordinal
+// choices. The operators are shared by every clone and child of the
+// chromosome.
+type Chromosome struct {
+	chromosome.Chromosome
+	Phenotype []int
+	ranges    *Ranges
+	mutation  IMutation
+	crossover ICrossover
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// SETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// SetRanges sets the ranges of the chromosome
+func (c *Chromosome) SetRanges(ranges *Ranges) {
+	c.ranges = ranges
+}
+
+// SetMutation sets the mutation operator of the chromosome
+func (c *Chromosome) SetMutation(mutation IMutation) {
+	c.mutation = mutation
+}
+
+// SetCrossover sets the crossover operator of the chromosome
+func (c *Chromosome) SetCrossover(crossover ICrossover) {
+	c.crossover = crossover
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// GETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// GetRanges returns the ranges of the chromosome
+func (c *Chromosome) GetRanges() *Ranges {
+	return c.ranges
+}
+
+// GetPhenotype returns the phenotype of the chromosome
+func (c *Chromosome) GetPhenotype() interface{} {
+	return c.Phenotype
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// ICHROMOSOME IMPLEMENTATIONS ////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// Generate creates a uniformly random vector within the ranges
+func (c *Chromosome) Generate() {
+	c.Phenotype = make([]int, c.GetDimensions())
+	for i := range c.Phenotype {
+		c.Phenotype[i] = c.ranges.Sample(i, c.GetGenerator())
+	}
+}
+
+// Mutate applies the mutation operator and clamps the result
+func (c *Chromosome) Mutate(mutationProbability float64) {
+	c.mutation.Mutate(c.Phenotype, mutationProbability, c.ranges, c.GetGenerator())
+	c.ranges.Clamp(c.Phenotype)
+}
+
+// Clone creates a new copy of the chromosome
+func (c *Chromosome) Clone(rng generator.IGenerator) chromosome.IChromosome {
+	return c.with(append([]int(nil), c.Phenotype...), rng)
+}
+
+// Crossover applies the crossover operator with the other chromosome and
+// returns the children
+func (c *Chromosome) Crossover(other chromosome.IChromosome, rng generator.IGenerator) []chromosome.IChromosome {
+
+	phenotypes := c.crossover.Crossover(c.Phenotype, other.(*Chromosome).Phenotype, rng)
+
+	children := make([]chromosome.IChromosome, len(phenotypes))
+	for i, phenotype := range phenotypes {
+		children[i] = c.with(phenotype, rng)
+	}
+
+	return children
+}
+
+// Distance returns the manhattan distance to the other chromosome
+func (c *Chromosome) Distance(other chromosome.IChromosome) float64 {
+	mate := other.(*Chromosome)
+	distance := 0
+	for i := range c.Phenotype {
+		if c.Phenotype[i] > mate.Phenotype[i] {
+			distance += c.Phenotype[i] - mate.Phenotype[i]
+		} else {
+			distance += mate.Phenotype[i] - c.Phenotype[i]
+		}
+	}
+	return float64(distance)
+}
+
+// with creates a chromosome with the same configuration holding the phenotype
+func (c *Chromosome) with(phenotype []int, rng generator.IGenerator) *Chromosome {
+	chr := NewChromosome(c.GetDimensions(), rng, c.ranges).(*Chromosome)
+	chr.SetMutation(c.mutation)
+	chr.SetCrossover(c.crossover)
+	chr.Phenotype = phenotype
+	return chr
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// CONSTRUCTOR ////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// NewChromosome creates a new instance of an integer Chromosome using random
+// reset mutation and uniform crossover by default
+func NewChromosome(dimensions int, rng generator.IGenerator, ranges *Ranges) chromosome.IChromosome {
+	chr := &Chromosome{}
+	chr.SetGenerator(rng)
+	chr.SetDimensions(dimensions)
+	chr.SetRanges(ranges)
+	chr.SetMutation(NewRandomResetMutation())
+	chr.SetCrossover(NewUniformCrossover())
+	return chr
+}
@@ -0,0 +1,44 @@
+package integer
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// CreepMutation adds or subtracts a random step of at most the configured
+// size from a gene, which suits ordinal genes
+type CreepMutation struct {
+	step int
+}
+
+// SetStep sets the largest step a gene can creep by, which is at least one
+func (m *CreepMutation) SetStep(step int) {
+	if step < 1 {
+		step = 1
+	}
+	m.step = step
+}
+
+// GetStep returns the largest step a gene can creep by
+func (m *CreepMutation) GetStep() int {
+	return m.step
+}
+
+// Mutate applies the mutation to the phenotype
+func (m *CreepMutation) Mutate(phenotype []int, probability float64, ranges *Ranges, rng generator.IGenerator) {
+	for i := range phenotype {
+		if rng.Float64() < probability {
+			step := rng.IntRange(1, m.step+1)
+			if rng.Float64() < 0.5 {
+				step = -step
+			}
+			phenotype[i] += step
+		}
+	}
+}
+
+// NewCreepMutation creates a new instance of the CreepMutation
+func NewCreepMutation(step int) IMutation {
+	m := &CreepMutation{}
+	m.SetStep(step)
+	return m
+}
@@ -0,0 +1,40 @@
+package integer
+
+import (
+	"math"
+
+	"github.com/opticverge/goevolution/generator"
+)
+
+// GaussianMutation adds normally distributed noise rounded to the nearest
+// integer to a gene, where the standard deviation is a proportion of the
+// span of the gene
+type GaussianMutation struct {
+	sigma float64
+}
+
+// SetSigma sets the standard deviation as a proportion of the span
+func (m *GaussianMutation) SetSigma(sigma float64) {
+	m.sigma = sigma
+}
+
+// GetSigma returns the standard deviation as a proportion of the span
+func (m *GaussianMutation) GetSigma() float64 {
+	return m.sigma
+}
+
+// Mutate applies the mutation to the phenotype
+func (m *GaussianMutation) Mutate(phenotype []int, probability float64, ranges *Ranges, rng generator.IGenerator) {
+	for i := range phenotype {
+		if rng.Float64() < probability {
+			phenotype[i] += int(math.Round(rng.NormFloat64() * m.sigma * float64(ranges.GetSpan(i))))
+		}
+	}
+}
+
+// NewGaussianMutation creates a new instance of the GaussianMutation
+func NewGaussianMutation(sigma float64) IMutation {
+	m := &GaussianMutation{}
+	m.SetSigma(sigma)
+	return m
+}
@@ -0,0 +1,11 @@
+package integer
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// ICrossover represents the interface for crossovers of integer phenotypes.
+// The children returned are new phenotypes holding genes of the parents.
+type ICrossover interface {
+	Crossover(first []int, second []int, rng generator.IGenerator) [][]int
+}
@@ -0,0 +1,12 @@
+package integer
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// IMutation represents the interface for mutations of integer phenotypes.
+// Each gene is mutated with the given probability and the phenotype is
+// clamped to the ranges afterwards by the chromosome.
+type IMutation interface {
+	Mutate(phenotype []int, probability float64, ranges *Ranges, rng generator.IGenerator)
+}
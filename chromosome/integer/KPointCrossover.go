@@ -0,0 +1,61 @@
+package integer
+
+import (
+	"sort"
+
+	"github.com/opticverge/goevolution/generator"
+)
+
+// KPointCrossover cuts the parents at k distinct random points and exchanges
+// every other section between them. When there are fewer positions than
+// points every position is cut.
+type KPointCrossover struct {
+	points int
+}
+
+// SetPoints sets the number of cut points
+func (k *KPointCrossover) SetPoints(points int) {
+	k.points = points
+}
+
+// GetPoints returns the number of cut points
+func (k *KPointCrossover) GetPoints() int {
+	return k.points
+}
+
+// Crossover produces two children from the parents
+func (k *KPointCrossover) Crossover(first []int, second []int, rng generator.IGenerator) [][]int {
+
+	left := append([]int(nil), first...)
+	right := append([]int(nil), second...)
+	if len(left) < 2 {
+		return [][]int{left, right}
+	}
+
+	// the cut points lie between genes, at positions 1 to length - 1
+	cuts := rng.Permutation(len(left) - 1)
+	if k.points < len(cuts) {
+		cuts = cuts[:k.points]
+	}
+	sort.Ints(cuts)
+
+	swap := false
+	for i, c := 0, 0; i < len(left); i++ {
+		if c < len(cuts) && i == cuts[c]+1 {
+			swap = !swap
+			c++
+		}
+		if swap {
+			left[i], right[i] = right[i], left[i]
+		}
+	}
+
+	return [][]int{left, right}
+}
+
+// NewKPointCrossover creates a new instance of the KPointCrossover
+func NewKPointCrossover(points int) ICrossover {
+	k := &KPointCrossover{}
+	k.SetPoints(points)
+	return k
+}
@@ -0,0 +1,49 @@
+package integer
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/problem"
+)
+
+// Problem is the base struct for problems over integer vectors. It generates
+// chromosomes with its ranges and operators so that a new problem which
+// embeds it only needs to implement the ObjectiveFunction.
+type Problem struct {
+	problem.Problem
+	ranges    *Ranges
+	mutation  IMutation
+	crossover ICrossover
+}
+
+// SetRanges sets the ranges of the problem
+func (p *Problem) SetRanges(ranges *Ranges) {
+	p.ranges = ranges
+	p.SetDimensions(ranges.GetDimensions())
+}
+
+// SetMutation sets the mutation operator given to generated chromosomes
+func (p *Problem) SetMutation(mutation IMutation) {
+	p.mutation = mutation
+}
+
+// SetCrossover sets the crossover operator given to generated chromosomes
+func (p *Problem) SetCrossover(crossover ICrossover) {
+	p.crossover = crossover
+}
+
+// GetRanges returns the ranges of the problem
+func (p *Problem) GetRanges() *Ranges {
+	return p.ranges
+}
+
+// GenerateChromosome creates a new integer Chromosome
+func (p *Problem) GenerateChromosome() chromosome.IChromosome {
+	chr := NewChromosome(p.GetDimensions(), p.DeriveGenerator(), p.ranges).(*Chromosome)
+	if p.mutation != nil {
+		chr.SetMutation(p.mutation)
+	}
+	if p.crossover != nil {
+		chr.SetCrossover(p.crossover)
+	}
+	return chr
+}
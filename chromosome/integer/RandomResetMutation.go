@@ -0,0 +1,23 @@
+package integer
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// RandomResetMutation replaces a gene with a uniformly random value from its
+// range, which suits categorical genes where values have no order
+type RandomResetMutation struct{}
+
+// Mutate applies the mutation to the phenotype
+func (m *RandomResetMutation) Mutate(phenotype []int, probability float64, ranges *Ranges, rng generator.IGenerator) {
+	for i := range phenotype {
+		if rng.Float64() < probability {
+			phenotype[i] = ranges.Sample(i, rng)
+		}
+	}
+}
+
+// NewRandomResetMutation creates a new instance of the RandomResetMutation
+func NewRandomResetMutation() IMutation {
+	return &RandomResetMutation{}
+}
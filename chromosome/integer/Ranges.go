@@ -0,0 +1,88 @@
+package integer
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// Ranges holds the inclusive minimum and maximum of every gene. A gene whose
+// minimum equals its maximum is fixed.
+type Ranges struct {
+	min []int
+	max []int
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// GETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// GetMin returns the minimum value of the gene
+func (r *Ranges) GetMin(gene int) int {
+	return r.min[gene]
+}
+
+// GetMax returns the maximum value of the gene
+func (r *Ranges) GetMax(gene int) int {
+	return r.max[gene]
+}
+
+// GetSpan returns the difference between the maximum and minimum of the gene
+func (r *Ranges) GetSpan(gene int) int {
+	return r.max[gene] - r.min[gene]
+}
+
+// GetDimensions returns the number of genes of the ranges
+func (r *Ranges) GetDimensions() int {
+	return len(r.min)
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// BEHAVIOURS /////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// Contains reports whether the value lies within the range of the gene
+func (r *Ranges) Contains(gene int, value int) bool {
+	return value >= r.min[gene] && value <= r.max[gene]
+}
+
+// Sample returns a uniformly random value within the range of the gene. The
+// maximum of IntRange is exclusive so it is extended by one, which also
+// keeps fixed genes from producing an empty range.
+func (r *Ranges) Sample(gene int, rng generator.IGenerator) int {
+	return rng.IntRange(r.min[gene], r.max[gene]+1)
+}
+
+// Clamp moves every gene of the phenotype outside of its range to the
+// nearest end of the range
+func (r *Ranges) Clamp(phenotype []int) {
+	for i, value := range phenotype {
+		if value < r.min[i] {
+			phenotype[i] = r.min[i]
+		} else if value > r.max[i] {
+			phenotype[i] = r.max[i]
+		}
+	}
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// CONSTRUCTORS ///////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// NewRanges creates new Ranges from the minimum and maximum of every gene
+func NewRanges(min []int, max []int) *Ranges {
+	return &Ranges{
+		min: append([]int(nil), min...),
+		max: append([]int(nil), max...),
+	}
+}
+
+// NewUniformRanges creates new Ranges where every gene shares the same
+// minimum and maximum
+func NewUniformRanges(dimensions int, min int, max int) *Ranges {
+	mins := make([]int, dimensions)
+	maxs := make([]int, dimensions)
+	for i := 0; i < dimensions; i++ {
+		mins[i] = min
+		maxs[i] = max
+	}
+	return NewRanges(mins, maxs)
+}
@@ -0,0 +1,26 @@
+package integer
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// UniformCrossover exchanges every gene of the parents with a probability of
+// one half
+type UniformCrossover struct{}
+
+// Crossover produces two children from the parents
+func (u *UniformCrossover) Crossover(first []int, second []int, rng generator.IGenerator) [][]int {
+	left := append([]int(nil), first...)
+	right := append([]int(nil), second...)
+	for i := range left {
+		if rng.Float64() < 0.5 {
+			left[i], right[i] = right[i], left[i]
+		}
+	}
+	return [][]int{left, right}
+}
+
+// NewUniformCrossover creates a new instance of the UniformCrossover
+func NewUniformCrossover() ICrossover {
+	return &UniformCrossover{}
+}
@@ -0,0 +1,110 @@
+package permutation
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/generator"
+)
+
+// Chromosome represents an ordering of the values from 0 to the number of
+// dimensions, such as the visiting order of a tour or the sequence of jobs in
+// a schedule. Every operator keeps the phenotype a valid permutation and the
+// operators are shared by every clone and child of the chromosome.
+type Chromosome struct {
+	chromosome.Chromosome
+	Phenotype []int
+	mutation  IMutation
+	crossover ICrossover
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// SETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// SetMutation sets the mutation operator of the chromosome
+func (c *Chromosome) SetMutation(mutation IMutation) {
+	c.mutation = mutation
+}
+
+// SetCrossover sets the crossover operator of the chromosome
+func (c *Chromosome) SetCrossover(crossover ICrossover) {
+	c.crossover = crossover
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// GETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// GetPhenotype returns the phenotype of the chromosome
+func (c *Chromosome) GetPhenotype() interface{} {
+	return c.Phenotype
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// ICHROMOSOME IMPLEMENTATIONS ////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// Generate creates a uniformly random permutation
+func (c *Chromosome) Generate() {
+	c.Phenotype = c.GetGenerator().Permutation(c.GetDimensions())
+}
+
+// Mutate applies the mutation operator
+func (c *Chromosome) Mutate(mutationProbability float64) {
+	c.mutation.Mutate(c.Phenotype, mutationProbability, c.GetGenerator())
+}
+
+// Clone creates a new copy of the chromosome
+func (c *Chromosome) Clone(rng generator.IGenerator) chromosome.IChromosome {
+	return c.with(append([]int(nil), c.Phenotype...), rng)
+}
+
+// Crossover applies the crossover operator with the other chromosome and
+// returns the children
+func (c *Chromosome) Crossover(other chromosome.IChromosome, rng generator.IGenerator) []chromosome.IChromosome {
+
+	phenotypes := c.crossover.Crossover(c.Phenotype, other.(*Chromosome).Phenotype, rng)
+
+	children := make([]chromosome.IChromosome, len(phenotypes))
+	for i, phenotype := range phenotypes {
+		children[i] = c.with(phenotype, rng)
+	}
+
+	return children
+}
+
+// Distance returns the number of positions holding different genes in the
+// other chromosome
+func (c *Chromosome) Distance(other chromosome.IChromosome) float64 {
+	mate := other.(*Chromosome)
+	distance := 0
+	for i := range c.Phenotype {
+		if c.Phenotype[i] != mate.Phenotype[i] {
+			distance++
+		}
+	}
+	return float64(distance)
+}
+
+// with creates a chromosome with the same configuration holding the phenotype
+func (c *Chromosome) with(phenotype []int, rng generator.IGenerator) *Chromosome {
+	chr := NewChromosome(c.GetDimensions(), rng).(*Chromosome)
+	chr.SetMutation(c.mutation)
+	chr.SetCrossover(c.crossover)
+	chr.Phenotype = phenotype
+	return chr
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// CONSTRUCTOR ////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// NewChromosome creates a new instance of a permutation Chromosome using
+// inversion mutation and order crossover by default
+func NewChromosome(dimensions int, rng generator.IGenerator) chromosome.IChromosome {
+	chr := &Chromosome{}
+	chr.SetGenerator(rng)
+	chr.SetDimensions(dimensions)
+	chr.SetMutation(NewInversionMutation())
+	chr.SetCrossover(NewOrderCrossover())
+	return chr
+}
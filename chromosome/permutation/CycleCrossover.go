@@ -0,0 +1,42 @@
+package permutation
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// CycleCrossover (CX) divides the positions into the cycles defined by the
+// parents and takes alternate cycles from each parent, so that every gene
+// keeps the position it had in one of the parents
+type CycleCrossover struct{}
+
+// Crossover produces two children from the parents
+func (c *CycleCrossover) Crossover(first []int, second []int, rng generator.IGenerator) [][]int {
+
+	left := make([]int, len(first))
+	right := make([]int, len(first))
+	visited := make([]bool, len(first))
+	positions := Positions(first)
+
+	cycle := 0
+	for start := range first {
+		if visited[start] {
+			continue
+		}
+		for i := start; !visited[i]; i = positions[second[i]] {
+			visited[i] = true
+			if cycle%2 == 0 {
+				left[i], right[i] = first[i], second[i]
+			} else {
+				left[i], right[i] = second[i], first[i]
+			}
+		}
+		cycle++
+	}
+
+	return [][]int{left, right}
+}
+
+// NewCycleCrossover creates a new instance of the CycleCrossover
+func NewCycleCrossover() ICrossover {
+	return &CycleCrossover{}
+}
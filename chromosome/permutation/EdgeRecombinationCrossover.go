@@ -0,0 +1,111 @@
+package permutation
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// EdgeRecombinationCrossover (ERX) builds a child from the union of the
+// edges of both parents, treated as tours, so that adjacency rather than
+// position is inherited. Each step moves to the neighbour with the fewest
+// remaining edges and only introduces a new edge when none are left.
+type EdgeRecombinationCrossover struct{}
+
+// Crossover produces two children from the parents, starting each from the
+// first gene of one of the parents
+func (e *EdgeRecombinationCrossover) Crossover(first []int, second []int, rng generator.IGenerator) [][]int {
+	return [][]int{
+		e.child(first, second, first[0], rng),
+		e.child(first, second, second[0], rng),
+	}
+}
+
+// child creates a child starting from the gene
+func (e *EdgeRecombinationCrossover) child(first []int, second []int, gene int, rng generator.IGenerator) []int {
+
+	length := len(first)
+	edges := e.edges(first, second)
+
+	// the genes not yet in the child and the position of each in that slice
+	remaining := make([]int, length)
+	index := make([]int, length)
+	for i := range remaining {
+		remaining[i] = i
+		index[i] = i
+	}
+
+	child := make([]int, 0, length)
+	for {
+		child = append(child, gene)
+
+		last := remaining[len(remaining)-1]
+		remaining[index[gene]], index[last] = last, index[gene]
+		remaining = remaining[:len(remaining)-1]
+		if len(remaining) == 0 {
+			return child
+		}
+
+		for _, neighbour := range edges[gene] {
+			edges[neighbour] = remove(edges[neighbour], gene)
+		}
+
+		next, ties := -1, 0
+		for _, neighbour := range edges[gene] {
+			switch {
+			case next == -1 || len(edges[neighbour]) < len(edges[next]):
+				next, ties = neighbour, 1
+			case len(edges[neighbour]) == len(edges[next]):
+				ties++
+				if rng.Intn(ties) == 0 {
+					next = neighbour
+				}
+			}
+		}
+
+		if next == -1 {
+			next = remaining[rng.Intn(len(remaining))]
+		}
+		gene = next
+	}
+}
+
+// edges returns the distinct neighbours of every gene in either parent
+func (e *EdgeRecombinationCrossover) edges(first []int, second []int) [][]int {
+	length := len(first)
+	edges := make([][]int, length)
+	for _, parent := range [][]int{first, second} {
+		for i, gene := range parent {
+			for _, neighbour := range []int{parent[(i+length-1)%length], parent[(i+1)%length]} {
+				if neighbour != gene && !contains(edges[gene], neighbour) {
+					edges[gene] = append(edges[gene], neighbour)
+				}
+			}
+		}
+	}
+	return edges
+}
+
+// contains returns true when the genes hold the gene
+func contains(genes []int, gene int) bool {
+	for _, g := range genes {
+		if g == gene {
+			return true
+		}
+	}
+	return false
+}
+
+// remove returns the genes without the gene
+func remove(genes []int, gene int) []int {
+	for i, g := range genes {
+		if g == gene {
+			return append(genes[:i], genes[i+1:]...)
+		}
+	}
+	return genes
+}
+
+// NewEdgeRecombinationCrossover creates a new instance of the
+// EdgeRecombinationCrossover
+func NewEdgeRecombinationCrossover() ICrossover {
+	return &EdgeRecombinationCrossover{}
+}
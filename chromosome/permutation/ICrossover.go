@@ -0,0 +1,11 @@
+package permutation
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// ICrossover represents the interface for crossovers of permutations. The
+// children returned are new phenotypes which are valid permutations.
+type ICrossover interface {
+	Crossover(first []int, second []int, rng generator.IGenerator) [][]int
+}
@@ -0,0 +1,12 @@
+package permutation
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// IMutation represents the interface for mutations of permutations. Every
+// position starts a move of the operator with the given probability and the
+// phenotype is always left a valid permutation.
+type IMutation interface {
+	Mutate(phenotype []int, probability float64, rng generator.IGenerator)
+}
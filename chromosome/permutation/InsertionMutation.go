@@ -0,0 +1,30 @@
+package permutation
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// InsertionMutation removes a gene and inserts it at a random position,
+// shifting the genes in between
+type InsertionMutation struct{}
+
+// Mutate applies the mutation to the phenotype
+func (m *InsertionMutation) Mutate(phenotype []int, probability float64, rng generator.IGenerator) {
+	for i := range phenotype {
+		if rng.Float64() < probability {
+			j := rng.Intn(len(phenotype))
+			gene := phenotype[i]
+			if i < j {
+				copy(phenotype[i:j], phenotype[i+1:j+1])
+			} else {
+				copy(phenotype[j+1:i+1], phenotype[j:i])
+			}
+			phenotype[j] = gene
+		}
+	}
+}
+
+// NewInsertionMutation creates a new instance of the InsertionMutation
+func NewInsertionMutation() IMutation {
+	return &InsertionMutation{}
+}
@@ -0,0 +1,28 @@
+package permutation
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// InversionMutation reverses the order of the genes between a gene and a
+// random position, which is the 2-opt move for routing problems
+type InversionMutation struct{}
+
+// Mutate applies the mutation to the phenotype
+func (m *InversionMutation) Mutate(phenotype []int, probability float64, rng generator.IGenerator) {
+	for i := range phenotype {
+		if rng.Float64() < probability {
+			j := rng.Intn(len(phenotype))
+			if i < j {
+				reverse(phenotype, i, j)
+			} else {
+				reverse(phenotype, j, i)
+			}
+		}
+	}
+}
+
+// NewInversionMutation creates a new instance of the InversionMutation
+func NewInversionMutation() IMutation {
+	return &InversionMutation{}
+}
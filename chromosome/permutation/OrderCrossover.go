@@ -0,0 +1,48 @@
+package permutation
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// OrderCrossover (OX) copies a random segment from one parent and fills the
+// remaining positions with the genes of the other parent in their relative
+// order, starting after the segment
+type OrderCrossover struct{}
+
+// Crossover produces two children from the parents
+func (o *OrderCrossover) Crossover(first []int, second []int, rng generator.IGenerator) [][]int {
+	from, to := segment(len(first), rng)
+	return [][]int{
+		o.child(first, second, from, to),
+		o.child(second, first, from, to),
+	}
+}
+
+// child creates the child holding the segment of the donor
+func (o *OrderCrossover) child(donor []int, other []int, from int, to int) []int {
+
+	length := len(donor)
+	child := make([]int, length)
+	placed := make([]bool, length)
+	for i := from; i <= to; i++ {
+		child[i] = donor[i]
+		placed[donor[i]] = true
+	}
+
+	position := (to + 1) % length
+	for k := 0; k < length; k++ {
+		gene := other[(to+1+k)%length]
+		if placed[gene] {
+			continue
+		}
+		child[position] = gene
+		position = (position + 1) % length
+	}
+
+	return child
+}
+
+// NewOrderCrossover creates a new instance of the OrderCrossover
+func NewOrderCrossover() ICrossover {
+	return &OrderCrossover{}
+}
@@ -0,0 +1,61 @@
+package permutation
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// PartiallyMappedCrossover (PMX) copies a random segment from one parent
+// and places the genes of the other parent by following the mapping defined
+// by the segment, which preserves absolute positions
+type PartiallyMappedCrossover struct{}
+
+// Crossover produces two children from the parents
+func (p *PartiallyMappedCrossover) Crossover(first []int, second []int, rng generator.IGenerator) [][]int {
+	from, to := segment(len(first), rng)
+	return [][]int{
+		p.child(first, second, from, to),
+		p.child(second, first, from, to),
+	}
+}
+
+// child creates the child holding the segment of the donor
+func (p *PartiallyMappedCrossover) child(donor []int, other []int, from int, to int) []int {
+
+	child := make([]int, len(donor))
+	placed := make([]bool, len(donor))
+	for i := range child {
+		child[i] = -1
+	}
+	for i := from; i <= to; i++ {
+		child[i] = donor[i]
+		placed[donor[i]] = true
+	}
+
+	positions := Positions(other)
+	for i := from; i <= to; i++ {
+		if placed[other[i]] {
+			continue
+		}
+		// follow the mapping until a position outside the segment is found
+		j := i
+		for j >= from && j <= to {
+			j = positions[donor[j]]
+		}
+		child[j] = other[i]
+		placed[other[i]] = true
+	}
+
+	for i := range child {
+		if child[i] == -1 {
+			child[i] = other[i]
+		}
+	}
+
+	return child
+}
+
+// NewPartiallyMappedCrossover creates a new instance of the
+// PartiallyMappedCrossover
+func NewPartiallyMappedCrossover() ICrossover {
+	return &PartiallyMappedCrossover{}
+}
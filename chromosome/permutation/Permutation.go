@@ -0,0 +1,44 @@
+package permutation
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// Valid returns true when the phenotype holds every value from 0 to its
+// length exactly once
+func Valid(phenotype []int) bool {
+	seen := make([]bool, len(phenotype))
+	for _, value := range phenotype {
+		if value < 0 || value >= len(phenotype) || seen[value] {
+			return false
+		}
+		seen[value] = true
+	}
+	return true
+}
+
+// Positions returns the position of every value in the phenotype
+func Positions(phenotype []int) []int {
+	positions := make([]int, len(phenotype))
+	for i, value := range phenotype {
+		positions[value] = i
+	}
+	return positions
+}
+
+// segment returns two random positions of a phenotype of the length where
+// the first is never greater than the second
+func segment(length int, rng generator.IGenerator) (int, int) {
+	from, to := rng.Intn(length), rng.Intn(length)
+	if from > to {
+		from, to = to, from
+	}
+	return from, to
+}
+
+// reverse reverses the genes between the positions inclusively
+func reverse(phenotype []int, from int, to int) {
+	for ; from < to; from, to = from+1, to-1 {
+		phenotype[from], phenotype[to] = phenotype[to], phenotype[from]
+	}
+}
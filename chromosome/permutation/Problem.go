@@ -0,0 +1,37 @@
+package permutation
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/problem"
+)
+
+// Problem is the base struct for problems over permutations. It generates
+// chromosomes with its operators so that a new problem which embeds it only
+// needs to implement the ObjectiveFunction.
+type Problem struct {
+	problem.Problem
+	mutation  IMutation
+	crossover ICrossover
+}
+
+// SetMutation sets the mutation operator given to generated chromosomes
+func (p *Problem) SetMutation(mutation IMutation) {
+	p.mutation = mutation
+}
+
+// SetCrossover sets the crossover operator given to generated chromosomes
+func (p *Problem) SetCrossover(crossover ICrossover) {
+	p.crossover = crossover
+}
+
+// GenerateChromosome creates a new permutation Chromosome
+func (p *Problem) GenerateChromosome() chromosome.IChromosome {
+	chr := NewChromosome(p.GetDimensions(), p.DeriveGenerator()).(*Chromosome)
+	if p.mutation != nil {
+		chr.SetMutation(p.mutation)
+	}
+	if p.crossover != nil {
+		chr.SetCrossover(p.crossover)
+	}
+	return chr
+}
@@ -0,0 +1,29 @@
+package permutation
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// ScrambleMutation shuffles the genes between a gene and a random position
+type ScrambleMutation struct{}
+
+// Mutate applies the mutation to the phenotype
+func (m *ScrambleMutation) Mutate(phenotype []int, probability float64, rng generator.IGenerator) {
+	for i := range phenotype {
+		if rng.Float64() < probability {
+			from, to := i, rng.Intn(len(phenotype))
+			if from > to {
+				from, to = to, from
+			}
+			for k := to; k > from; k-- {
+				l := from + rng.Intn(k-from+1)
+				phenotype[k], phenotype[l] = phenotype[l], phenotype[k]
+			}
+		}
+	}
+}
+
+// NewScrambleMutation creates a new instance of the ScrambleMutation
+func NewScrambleMutation() IMutation {
+	return &ScrambleMutation{}
+}
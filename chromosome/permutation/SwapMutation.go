@@ -0,0 +1,23 @@
+package permutation
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// SwapMutation exchanges a gene with another at a random position
+type SwapMutation struct{}
+
+// Mutate applies the mutation to the phenotype
+func (s *SwapMutation) Mutate(phenotype []int, probability float64, rng generator.IGenerator) {
+	for i := range phenotype {
+		if rng.Float64() < probability {
+			j := rng.Intn(len(phenotype))
+			phenotype[i], phenotype[j] = phenotype[j], phenotype[i]
+		}
+	}
+}
+
+// NewSwapMutation creates a new instance of the SwapMutation
+func NewSwapMutation() IMutation {
+	return &SwapMutation{}
+}
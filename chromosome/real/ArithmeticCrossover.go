@@ -0,0 +1,43 @@
+package real
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// ArithmeticCrossover produces children which are weighted averages of their
+// parents. A weight outside of [0, 1] draws a new random weight for every
+// crossover.
+type ArithmeticCrossover struct {
+	weight float64
+}
+
+// SetWeight sets the weight given to the first parent
+func (a *ArithmeticCrossover) SetWeight(weight float64) {
+	a.weight = weight
+}
+
+// Crossover produces two children from the parents
+func (a *ArithmeticCrossover) Crossover(first []float64, second []float64, bounds *Bounds, rng generator.IGenerator) [][]float64 {
+
+	weight := a.weight
+	if weight < 0 || weight > 1 {
+		weight = rng.Float64()
+	}
+
+	left := make([]float64, len(first))
+	right := make([]float64, len(first))
+
+	for i := range first {
+		left[i] = weight*first[i] + (1-weight)*second[i]
+		right[i] = (1-weight)*first[i] + weight*second[i]
+	}
+
+	return [][]float64{left, right}
+}
+
+// NewArithmeticCrossover creates a new instance of the ArithmeticCrossover
+func NewArithmeticCrossover(weight float64) ICrossover {
+	a := &ArithmeticCrossover{}
+	a.SetWeight(weight)
+	return a
+}
@@ -0,0 +1,44 @@
+package real
+
+import (
+	"math"
+
+	"github.com/opticverge/goevolution/generator"
+)
+
+// BlendCrossover implements BLX-alpha crossover. Each gene of a child is
+// drawn uniformly from the interval spanned by the genes of the parents,
+// extended on both sides by alpha times its length.
+type BlendCrossover struct {
+	alpha float64
+}
+
+// SetAlpha sets how far the interval is extended
+func (b *BlendCrossover) SetAlpha(alpha float64) {
+	b.alpha = alpha
+}
+
+// Crossover produces two children from the parents
+func (b *BlendCrossover) Crossover(first []float64, second []float64, bounds *Bounds, rng generator.IGenerator) [][]float64 {
+
+	children := [][]float64{make([]float64, len(first)), make([]float64, len(first))}
+
+	for i := range first {
+		low := math.Min(first[i], second[i])
+		high := math.Max(first[i], second[i])
+		extent := b.alpha * (high - low)
+
+		for _, child := range children {
+			child[i] = Uniform(rng, low-extent, high+extent)
+		}
+	}
+
+	return children
+}
+
+// NewBlendCrossover creates a new instance of the BlendCrossover
+func NewBlendCrossover(alpha float64) ICrossover {
+	b := &BlendCrossover{}
+	b.SetAlpha(alpha)
+	return b
+}
@@ -0,0 +1,170 @@
+package real
+
+import (
+	"math"
+
+	"github.com/opticverge/goevolution/generator"
+)
+
+// BoundPolicy is a type which defines how a gene that falls outside of its
+// bounds is brought back within them.
+type BoundPolicy string
+
+const (
+	// Clamp moves the gene to the nearest bound
+	Clamp BoundPolicy = "Clamp"
+
+	// Reflect mirrors the gene back into the bounds by the distance it
+	// exceeded them
+	Reflect BoundPolicy = "Reflect"
+
+	// Wrap treats the bounds as periodic so that leaving through the upper
+	// bound re-enters through the lower bound
+	Wrap BoundPolicy = "Wrap"
+
+	// Resample replaces the gene with a uniformly random value within the
+	// bounds
+	Resample BoundPolicy = "Resample"
+)
+
+// Bounds holds the lower and upper bound of every dimension together with
+// the policy used to repair genes that leave them.
+type Bounds struct {
+	lower  []float64
+	upper  []float64
+	policy BoundPolicy
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// SETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// SetPolicy sets the policy used to repair genes outside of the bounds
+func (b *Bounds) SetPolicy(policy BoundPolicy) {
+	b.policy = policy
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// GETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// GetLower returns the lower bound of the dimension
+func (b *Bounds) GetLower(dimension int) float64 {
+	return b.lower[dimension]
+}
+
+// GetUpper returns the upper bound of the dimension
+func (b *Bounds) GetUpper(dimension int) float64 {
+	return b.upper[dimension]
+}
+
+// GetRange returns the distance between the bounds of the dimension
+func (b *Bounds) GetRange(dimension int) float64 {
+	return b.upper[dimension] - b.lower[dimension]
+}
+
+// GetPolicy returns the policy used to repair genes outside of the bounds
+func (b *Bounds) GetPolicy() BoundPolicy {
+	return b.policy
+}
+
+// GetDimensions returns the number of dimensions of the bounds
+func (b *Bounds) GetDimensions() int {
+	return len(b.lower)
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// BEHAVIOURS /////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// Contains reports whether the value lies within the bounds of the dimension
+func (b *Bounds) Contains(dimension int, value float64) bool {
+	return value >= b.lower[dimension] && value <= b.upper[dimension]
+}
+
+// Sample returns a uniformly random value within the bounds of the dimension
+func (b *Bounds) Sample(dimension int, rng generator.IGenerator) float64 {
+	return Uniform(rng, b.lower[dimension], b.upper[dimension])
+}
+
+// Repair brings every gene of the phenotype back within the bounds according
+// to the policy
+func (b *Bounds) Repair(phenotype []float64, rng generator.IGenerator) {
+	for i, value := range phenotype {
+		if !b.Contains(i, value) {
+			phenotype[i] = b.RepairGene(i, value, rng)
+		}
+	}
+}
+
+// RepairGene brings a single value back within the bounds of the dimension
+// according to the policy
+func (b *Bounds) RepairGene(dimension int, value float64, rng generator.IGenerator) float64 {
+
+	lower, upper := b.lower[dimension], b.upper[dimension]
+	width := upper - lower
+
+	if width <= 0 {
+		return lower
+	}
+
+	switch b.policy {
+	case Reflect:
+		offset := positiveMod(value-lower, 2*width)
+		if offset > width {
+			offset = 2*width - offset
+		}
+		return lower + offset
+	case Wrap:
+		return lower + positiveMod(value-lower, width)
+	case Resample:
+		return b.Sample(dimension, rng)
+	default:
+		return math.Max(lower, math.Min(upper, value))
+	}
+}
+
+// positiveMod returns the remainder of a divided by b within [0, b)
+func positiveMod(a float64, b float64) float64 {
+	m := math.Mod(a, b)
+	if m < 0 {
+		m += b
+	}
+	return m
+}
+
+// Uniform returns a uniformly random value between min and max, returning
+// min when the two are equal
+func Uniform(rng generator.IGenerator, min float64, max float64) float64 {
+	if min == max {
+		return min
+	}
+	return rng.FloatRange(min, max)
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// CONSTRUCTOR ////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// NewBounds creates new Bounds from the lower and upper bound of every
+// dimension
+func NewBounds(lower []float64, upper []float64, policy BoundPolicy) *Bounds {
+	b := &Bounds{
+		lower: append([]float64(nil), lower...),
+		upper: append([]float64(nil), upper...),
+	}
+	b.SetPolicy(policy)
+	return b
+}
+
+// NewUniformBounds creates new Bounds where every dimension shares the same
+// lower and upper bound
+func NewUniformBounds(dimensions int, lower float64, upper float64, policy BoundPolicy) *Bounds {
+	lowers := make([]float64, dimensions)
+	uppers := make([]float64, dimensions)
+	for i := 0; i < dimensions; i++ {
+		lowers[i] = lower
+		uppers[i] = upper
+	}
+	return NewBounds(lowers, uppers, policy)
+}
@@ -0,0 +1,35 @@
+package real
+
+import (
+	"math"
+
+	"github.com/opticverge/goevolution/generator"
+)
+
+// CauchyMutation adds Cauchy distributed noise to a gene. The heavy tails of
+// the distribution produce occasional large jumps which help to escape local
+// optima. The scale is a proportion of the range of the dimension.
+type CauchyMutation struct {
+	scale float64
+}
+
+// SetScale sets the scale as a proportion of the range
+func (c *CauchyMutation) SetScale(scale float64) {
+	c.scale = scale
+}
+
+// Mutate applies the mutation to the phenotype
+func (c *CauchyMutation) Mutate(phenotype []float64, probability float64, bounds *Bounds, rng generator.IGenerator) {
+	for i := range phenotype {
+		if rng.Float64() < probability {
+			phenotype[i] += c.scale * bounds.GetRange(i) * math.Tan(math.Pi*(rng.Float64()-0.5))
+		}
+	}
+}
+
+// NewCauchyMutation creates a new instance of the CauchyMutation
+func NewCauchyMutation(scale float64) IMutation {
+	c := &CauchyMutation{}
+	c.SetScale(scale)
+	return c
+}
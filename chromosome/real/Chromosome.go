@@ -0,0 +1,125 @@
+package real
+
+import (
+	"math"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/generator"
+)
+
+// Chromosome represents a vector of real values where every dimension lies
+// within its bounds. The mutation and crossover operators are shared by
+// every clone and child of the chromosome.
+type Chromosome struct {
+	chromosome.Chromosome
+	Phenotype []float64
+	bounds    *Bounds
+	mutation  IMutation
+	crossover ICrossover
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// SETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// SetBounds sets the bounds of the chromosome
+func (c *Chromosome) SetBounds(bounds *Bounds) {
+	c.bounds = bounds
+}
+
+// SetMutation sets the mutation operator of the chromosome
+func (c *Chromosome) SetMutation(mutation IMutation) {
+	c.mutation = mutation
+}
+
+// SetCrossover sets the crossover operator of the chromosome
+func (c *Chromosome) SetCrossover(crossover ICrossover) {
+	c.crossover = crossover
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// GETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// GetBounds returns the bounds of the chromosome
+func (c *Chromosome) GetBounds() *Bounds {
+	return c.bounds
+}
+
+// GetPhenotype returns the phenotype of the chromosome
+func (c *Chromosome) GetPhenotype() interface{} {
+	return c.Phenotype
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// ICHROMOSOME IMPLEMENTATIONS ////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// Generate creates a uniformly random vector within the bounds
+func (c *Chromosome) Generate() {
+	c.Phenotype = make([]float64, c.GetDimensions())
+	for i := range c.Phenotype {
+		c.Phenotype[i] = c.bounds.Sample(i, c.GetGenerator())
+	}
+}
+
+// Mutate applies the mutation operator and repairs the result
+func (c *Chromosome) Mutate(mutationProbability float64) {
+	c.mutation.Mutate(c.Phenotype, mutationProbability, c.bounds, c.GetGenerator())
+	c.bounds.Repair(c.Phenotype, c.GetGenerator())
+}
+
+// Clone creates a new copy of the chromosome
+func (c *Chromosome) Clone(rng generator.IGenerator) chromosome.IChromosome {
+	return c.with(append([]float64(nil), c.Phenotype...), rng)
+}
+
+// Crossover applies the crossover operator with the other chromosome and
+// returns the repaired children
+func (c *Chromosome) Crossover(other chromosome.IChromosome, rng generator.IGenerator) []chromosome.IChromosome {
+
+	phenotypes := c.crossover.Crossover(c.Phenotype, other.(*Chromosome).Phenotype, c.bounds, rng)
+
+	children := make([]chromosome.IChromosome, len(phenotypes))
+	for i, phenotype := range phenotypes {
+		c.bounds.Repair(phenotype, rng)
+		children[i] = c.with(phenotype, rng)
+	}
+
+	return children
+}
+
+// Distance returns the euclidean distance to the other chromosome
+func (c *Chromosome) Distance(other chromosome.IChromosome) float64 {
+	mate := other.(*Chromosome)
+	total := 0.0
+	for i := range c.Phenotype {
+		total += (c.Phenotype[i] - mate.Phenotype[i]) * (c.Phenotype[i] - mate.Phenotype[i])
+	}
+	return math.Sqrt(total)
+}
+
+// with creates a chromosome with the same configuration holding the phenotype
+func (c *Chromosome) with(phenotype []float64, rng generator.IGenerator) *Chromosome {
+	chr := NewChromosome(c.GetDimensions(), rng, c.bounds).(*Chromosome)
+	chr.SetMutation(c.mutation)
+	chr.SetCrossover(c.crossover)
+	chr.Phenotype = phenotype
+	return chr
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// CONSTRUCTOR ////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// NewChromosome creates a new instance of a real valued Chromosome using
+// Gaussian mutation and simulated binary crossover by default
+func NewChromosome(dimensions int, rng generator.IGenerator, bounds *Bounds) chromosome.IChromosome {
+	chr := &Chromosome{}
+	chr.SetGenerator(rng)
+	chr.SetDimensions(dimensions)
+	chr.SetBounds(bounds)
+	chr.SetMutation(NewGaussianMutation(0.1))
+	chr.SetCrossover(NewSimulatedBinaryCrossover(15))
+	return chr
+}
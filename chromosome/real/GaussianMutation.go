@@ -0,0 +1,32 @@
+package real
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// GaussianMutation adds normally distributed noise to a gene where the
+// standard deviation is a proportion of the range of the dimension.
+type GaussianMutation struct {
+	sigma float64
+}
+
+// SetSigma sets the standard deviation as a proportion of the range
+func (g *GaussianMutation) SetSigma(sigma float64) {
+	g.sigma = sigma
+}
+
+// Mutate applies the mutation to the phenotype
+func (g *GaussianMutation) Mutate(phenotype []float64, probability float64, bounds *Bounds, rng generator.IGenerator) {
+	for i := range phenotype {
+		if rng.Float64() < probability {
+			phenotype[i] += rng.NormFloat64() * g.sigma * bounds.GetRange(i)
+		}
+	}
+}
+
+// NewGaussianMutation creates a new instance of the GaussianMutation
+func NewGaussianMutation(sigma float64) IMutation {
+	g := &GaussianMutation{}
+	g.SetSigma(sigma)
+	return g
+}
@@ -0,0 +1,12 @@
+package real
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// ICrossover represents the interface for crossovers of real valued
+// phenotypes. The children returned are new phenotypes which the chromosome
+// repairs against the bounds.
+type ICrossover interface {
+	Crossover(first []float64, second []float64, bounds *Bounds, rng generator.IGenerator) [][]float64
+}
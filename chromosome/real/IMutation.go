@@ -0,0 +1,12 @@
+package real
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// IMutation represents the interface for mutations of real valued
+// phenotypes. Each gene is mutated with the given probability and the
+// phenotype is repaired against the bounds afterwards by the chromosome.
+type IMutation interface {
+	Mutate(phenotype []float64, probability float64, bounds *Bounds, rng generator.IGenerator)
+}
@@ -0,0 +1,59 @@
+package real
+
+import (
+	"math"
+
+	"github.com/opticverge/goevolution/generator"
+)
+
+// PolynomialMutation implements the bounded polynomial mutation of Deb and
+// Goyal. The distribution index eta controls how close the mutated gene
+// stays to its original value, where larger values produce smaller changes.
+type PolynomialMutation struct {
+	eta float64
+}
+
+// SetEta sets the distribution index
+func (p *PolynomialMutation) SetEta(eta float64) {
+	p.eta = eta
+}
+
+// Mutate applies the mutation to the phenotype
+func (p *PolynomialMutation) Mutate(phenotype []float64, probability float64, bounds *Bounds, rng generator.IGenerator) {
+
+	power := 1.0 / (p.eta + 1.0)
+
+	for i, value := range phenotype {
+		if rng.Float64() >= probability {
+			continue
+		}
+
+		width := bounds.GetRange(i)
+		if width <= 0 {
+			continue
+		}
+
+		below := (value - bounds.GetLower(i)) / width
+		above := (bounds.GetUpper(i) - value) / width
+
+		r := rng.Float64()
+
+		var delta float64
+		if r < 0.5 {
+			base := 2*r + (1-2*r)*math.Pow(1-below, p.eta+1)
+			delta = math.Pow(base, power) - 1
+		} else {
+			base := 2*(1-r) + 2*(r-0.5)*math.Pow(1-above, p.eta+1)
+			delta = 1 - math.Pow(base, power)
+		}
+
+		phenotype[i] = value + delta*width
+	}
+}
+
+// NewPolynomialMutation creates a new instance of the PolynomialMutation
+func NewPolynomialMutation(eta float64) IMutation {
+	p := &PolynomialMutation{}
+	p.SetEta(eta)
+	return p
+}
@@ -0,0 +1,49 @@
+package real
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/problem"
+)
+
+// Problem is the base struct for problems over real valued vectors. It
+// generates chromosomes with its bounds and operators so that a new problem
+// which embeds it only needs to implement the ObjectiveFunction.
+type Problem struct {
+	problem.Problem
+	bounds    *Bounds
+	mutation  IMutation
+	crossover ICrossover
+}
+
+// SetBounds sets the bounds of the problem
+func (p *Problem) SetBounds(bounds *Bounds) {
+	p.bounds = bounds
+	p.SetDimensions(bounds.GetDimensions())
+}
+
+// SetMutation sets the mutation operator given to generated chromosomes
+func (p *Problem) SetMutation(mutation IMutation) {
+	p.mutation = mutation
+}
+
+// SetCrossover sets the crossover operator given to generated chromosomes
+func (p *Problem) SetCrossover(crossover ICrossover) {
+	p.crossover = crossover
+}
+
+// GetBounds returns the bounds of the problem
+func (p *Problem) GetBounds() *Bounds {
+	return p.bounds
+}
+
+// GenerateChromosome creates a new real valued Chromosome
+func (p *Problem) GenerateChromosome() chromosome.IChromosome {
+	chr := NewChromosome(p.GetDimensions(), p.DeriveGenerator(), p.bounds).(*Chromosome)
+	if p.mutation != nil {
+		chr.SetMutation(p.mutation)
+	}
+	if p.crossover != nil {
+		chr.SetCrossover(p.crossover)
+	}
+	return chr
+}
@@ -0,0 +1,56 @@
+package real
+
+import (
+	"math"
+
+	"github.com/opticverge/goevolution/generator"
+)
+
+// SimulatedBinaryCrossover implements SBX which simulates the behaviour of
+// single point crossover on binary strings. The distribution index eta
+// controls how close the children stay to their parents, where larger values
+// produce children closer to the parents.
+type SimulatedBinaryCrossover struct {
+	eta float64
+}
+
+// SetEta sets the distribution index
+func (s *SimulatedBinaryCrossover) SetEta(eta float64) {
+	s.eta = eta
+}
+
+// Crossover produces two children from the parents, recombining each gene
+// with a probability of one half
+func (s *SimulatedBinaryCrossover) Crossover(first []float64, second []float64, bounds *Bounds, rng generator.IGenerator) [][]float64 {
+
+	left := append([]float64(nil), first...)
+	right := append([]float64(nil), second...)
+
+	for i := range first {
+		if rng.Float64() >= 0.5 {
+			continue
+		}
+
+		u := rng.Float64()
+
+		var beta float64
+		if u <= 0.5 {
+			beta = math.Pow(2*u, 1/(s.eta+1))
+		} else {
+			beta = math.Pow(1/(2*(1-u)), 1/(s.eta+1))
+		}
+
+		left[i] = 0.5 * ((1+beta)*first[i] + (1-beta)*second[i])
+		right[i] = 0.5 * ((1-beta)*first[i] + (1+beta)*second[i])
+	}
+
+	return [][]float64{left, right}
+}
+
+// NewSimulatedBinaryCrossover creates a new instance of the
+// SimulatedBinaryCrossover
+func NewSimulatedBinaryCrossover(eta float64) ICrossover {
+	s := &SimulatedBinaryCrossover{}
+	s.SetEta(eta)
+	return s
+}
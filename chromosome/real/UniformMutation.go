@@ -0,0 +1,23 @@
+package real
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// UniformMutation replaces a gene with a uniformly random value within the
+// bounds of its dimension.
+type UniformMutation struct{}
+
+// Mutate applies the mutation to the phenotype
+func (u *UniformMutation) Mutate(phenotype []float64, probability float64, bounds *Bounds, rng generator.IGenerator) {
+	for i := range phenotype {
+		if rng.Float64() < probability {
+			phenotype[i] = bounds.Sample(i, rng)
+		}
+	}
+}
+
+// NewUniformMutation creates a new instance of the UniformMutation
+func NewUniformMutation() IMutation {
+	return &UniformMutation{}
+}
@@ -0,0 +1,173 @@
+package variable
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/generator"
+)
+
+// Chromosome represents a sequence of genes whose length changes under
+// variation, such as the rules of a rule set or the tracks of a playlist.
+// Every gene is a value in [0, alphabet) that indexes the choices of the
+// problem, and the dimensions of the chromosome are always its current
+// length. The operators are shared by every clone and child of the
+// chromosome.
+type Chromosome struct {
+	chromosome.Chromosome
+	Phenotype     []int
+	alphabet      int
+	minInitLength int
+	maxInitLength int
+	mutation      IMutation
+	crossover     ICrossover
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// SETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// SetAlphabet sets the number of distinct values of a gene
+func (c *Chromosome) SetAlphabet(alphabet int) {
+	c.alphabet = alphabet
+}
+
+// SetInitialLengths sets the range of lengths drawn from when the chromosome
+// is generated. When the maximum is zero the chromosome is generated with
+// its dimensions.
+func (c *Chromosome) SetInitialLengths(minLength int, maxLength int) {
+	c.minInitLength = minLength
+	c.maxInitLength = maxLength
+}
+
+// SetMutation sets the mutation operator of the chromosome
+func (c *Chromosome) SetMutation(mutation IMutation) {
+	c.mutation = mutation
+}
+
+// SetCrossover sets the crossover operator of the chromosome
+func (c *Chromosome) SetCrossover(crossover ICrossover) {
+	c.crossover = crossover
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// GETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// GetAlphabet returns the number of distinct values of a gene
+func (c *Chromosome) GetAlphabet() int {
+	return c.alphabet
+}
+
+// GetPhenotype returns the phenotype of the chromosome
+func (c *Chromosome) GetPhenotype() interface{} {
+	return c.Phenotype
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// ICHROMOSOME IMPLEMENTATIONS ////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// Generate creates a sequence of random genes
+func (c *Chromosome) Generate() {
+	length := c.GetDimensions()
+	if c.maxInitLength > 0 {
+		length = c.GetGenerator().IntRange(c.minInitLength, c.maxInitLength+1)
+	}
+	c.Phenotype = nil
+	c.Resize(length)
+}
+
+// Mutate applies the mutation operator, updating the dimensions to the new
+// length
+func (c *Chromosome) Mutate(mutationProbability float64) {
+	c.Phenotype = c.mutation.Mutate(c.Phenotype, mutationProbability, c.alphabet, c.GetGenerator())
+	c.SetDimensions(len(c.Phenotype))
+}
+
+// Resize truncates the sequence or extends it with random genes
+func (c *Chromosome) Resize(dimensions int) {
+	if dimensions < len(c.Phenotype) {
+		c.Phenotype = c.Phenotype[:dimensions]
+	}
+	for len(c.Phenotype) < dimensions {
+		c.Phenotype = append(c.Phenotype, c.GetGenerator().Intn(c.alphabet))
+	}
+	c.SetDimensions(dimensions)
+}
+
+// Clone creates a new copy of the chromosome
+func (c *Chromosome) Clone(rng generator.IGenerator) chromosome.IChromosome {
+	return c.with(append([]int(nil), c.Phenotype...), rng)
+}
+
+// Crossover applies the crossover operator with the other chromosome and
+// returns the children
+func (c *Chromosome) Crossover(other chromosome.IChromosome, rng generator.IGenerator) []chromosome.IChromosome {
+
+	phenotypes := c.crossover.Crossover(c.Phenotype, other.(*Chromosome).Phenotype, rng)
+
+	children := make([]chromosome.IChromosome, len(phenotypes))
+	for i, phenotype := range phenotypes {
+		children[i] = c.with(phenotype, rng)
+	}
+
+	return children
+}
+
+// Distance returns the edit distance to the other chromosome, which is the
+// fewest insertions, deletions and substitutions turning one into the other
+func (c *Chromosome) Distance(other chromosome.IChromosome) float64 {
+	mate := other.(*Chromosome).Phenotype
+
+	previous := make([]int, len(mate)+1)
+	current := make([]int, len(mate)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(c.Phenotype); i++ {
+		current[0] = i
+		for j := 1; j <= len(mate); j++ {
+			cost := 1
+			if c.Phenotype[i-1] == mate[j-1] {
+				cost = 0
+			}
+			current[j] = previous[j-1] + cost
+			if previous[j]+1 < current[j] {
+				current[j] = previous[j] + 1
+			}
+			if current[j-1]+1 < current[j] {
+				current[j] = current[j-1] + 1
+			}
+		}
+		previous, current = current, previous
+	}
+
+	return float64(previous[len(mate)])
+}
+
+// with creates a chromosome with the same configuration holding the phenotype
+func (c *Chromosome) with(phenotype []int, rng generator.IGenerator) *Chromosome {
+	chr := NewChromosome(len(phenotype), rng, c.alphabet).(*Chromosome)
+	chr.SetInitialLengths(c.minInitLength, c.maxInitLength)
+	chr.SetMutation(c.mutation)
+	chr.SetCrossover(c.crossover)
+	chr.Phenotype = phenotype
+	return chr
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// CONSTRUCTOR ////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// NewChromosome creates a new instance of a variable length Chromosome. By
+// default genes are reset, inserted and deleted by mutation and the parents
+// are recombined by cut and splice crossover.
+func NewChromosome(dimensions int, rng generator.IGenerator, alphabet int) chromosome.IChromosome {
+	chr := &Chromosome{}
+	chr.SetGenerator(rng)
+	chr.SetDimensions(dimensions)
+	chr.SetAlphabet(alphabet)
+	chr.SetMutation(NewSequenceMutation(NewResetMutation(), NewInsertionMutation(), NewDeletionMutation()))
+	chr.SetCrossover(NewCutAndSpliceCrossover())
+	return chr
+}
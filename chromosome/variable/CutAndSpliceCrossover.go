@@ -0,0 +1,25 @@
+package variable
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// CutAndSpliceCrossover cuts each parent at its own random point and
+// exchanges the tails, so that the lengths of the children differ from
+// those of the parents while their total length is kept
+type CutAndSpliceCrossover struct{}
+
+// Crossover produces two children from the parents
+func (c *CutAndSpliceCrossover) Crossover(first []int, second []int, rng generator.IGenerator) [][]int {
+	i, j := rng.Intn(len(first)+1), rng.Intn(len(second)+1)
+	return [][]int{
+		splice(first[:i], second[j:]),
+		splice(second[:j], first[i:]),
+	}
+}
+
+// NewCutAndSpliceCrossover creates a new instance of the
+// CutAndSpliceCrossover
+func NewCutAndSpliceCrossover() ICrossover {
+	return &CutAndSpliceCrossover{}
+}
@@ -0,0 +1,25 @@
+package variable
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// DeletionMutation removes a gene with the probability, which shortens the
+// phenotype
+type DeletionMutation struct{}
+
+// Mutate applies the mutation to the phenotype
+func (m *DeletionMutation) Mutate(phenotype []int, probability float64, alphabet int, rng generator.IGenerator) []int {
+	mutated := phenotype[:0]
+	for _, gene := range phenotype {
+		if rng.Float64() >= probability {
+			mutated = append(mutated, gene)
+		}
+	}
+	return mutated
+}
+
+// NewDeletionMutation creates a new instance of the DeletionMutation
+func NewDeletionMutation() IMutation {
+	return &DeletionMutation{}
+}
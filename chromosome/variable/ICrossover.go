@@ -0,0 +1,25 @@
+package variable
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// ICrossover represents the interface for crossovers of variable length
+// phenotypes. The children returned are new phenotypes whose lengths may
+// differ from those of the parents.
+type ICrossover interface {
+	Crossover(first []int, second []int, rng generator.IGenerator) [][]int
+}
+
+// splice returns a new phenotype joining the segments
+func splice(segments ...[]int) []int {
+	length := 0
+	for _, segment := range segments {
+		length += len(segment)
+	}
+	spliced := make([]int, 0, length)
+	for _, segment := range segments {
+		spliced = append(spliced, segment...)
+	}
+	return spliced
+}
@@ -0,0 +1,12 @@
+package variable
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// IMutation represents the interface for mutations of variable length
+// phenotypes. The mutated phenotype is returned since its length may change.
+// Genes are drawn from [0, alphabet).
+type IMutation interface {
+	Mutate(phenotype []int, probability float64, alphabet int, rng generator.IGenerator) []int
+}
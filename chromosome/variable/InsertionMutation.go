@@ -0,0 +1,28 @@
+package variable
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// InsertionMutation inserts a random gene before a gene, and at the end of
+// the phenotype, with the probability, which lengthens the phenotype
+type InsertionMutation struct{}
+
+// Mutate applies the mutation to the phenotype
+func (m *InsertionMutation) Mutate(phenotype []int, probability float64, alphabet int, rng generator.IGenerator) []int {
+	mutated := make([]int, 0, len(phenotype)+1)
+	for i := 0; i <= len(phenotype); i++ {
+		if rng.Float64() < probability {
+			mutated = append(mutated, rng.Intn(alphabet))
+		}
+		if i < len(phenotype) {
+			mutated = append(mutated, phenotype[i])
+		}
+	}
+	return mutated
+}
+
+// NewInsertionMutation creates a new instance of the InsertionMutation
+func NewInsertionMutation() IMutation {
+	return &InsertionMutation{}
+}
@@ -0,0 +1,84 @@
+package variable
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// MessyCrossover applies the cut and splice operators of the messy genetic
+// algorithm. Each parent is cut at a random point with a probability that
+// grows with its length, then the segments are queued in the order head of
+// the first, tail of the second, head of the second and tail of the first,
+// and each segment is spliced onto the one before it with the splice
+// probability. The number of children therefore varies from one to four,
+// while their total length is always that of the parents.
+type MessyCrossover struct {
+	cut    float64
+	splice float64
+}
+
+// SetCut sets the probability of cutting a parent per gene of its length
+func (m *MessyCrossover) SetCut(cut float64) {
+	m.cut = cut
+}
+
+// SetSplice sets the probability of splicing consecutive segments
+func (m *MessyCrossover) SetSplice(splice float64) {
+	m.splice = splice
+}
+
+// GetCut returns the probability of cutting a parent per gene of its length
+func (m *MessyCrossover) GetCut() float64 {
+	return m.cut
+}
+
+// GetSplice returns the probability of splicing consecutive segments
+func (m *MessyCrossover) GetSplice() float64 {
+	return m.splice
+}
+
+// Crossover produces the children from the parents
+func (m *MessyCrossover) Crossover(first []int, second []int, rng generator.IGenerator) [][]int {
+
+	firstHead, firstTail, firstCut := m.cutAt(first, rng)
+	secondHead, secondTail, secondCut := m.cutAt(second, rng)
+
+	queue := [][]int{firstHead}
+	if secondCut {
+		queue = append(queue, secondTail)
+	}
+	queue = append(queue, secondHead)
+	if firstCut {
+		queue = append(queue, firstTail)
+	}
+
+	var children [][]int
+	current := splice(queue[0])
+	for _, segment := range queue[1:] {
+		if rng.Float64() < m.splice {
+			current = splice(current, segment)
+			continue
+		}
+		children = append(children, current)
+		current = splice(segment)
+	}
+
+	return append(children, current)
+}
+
+// cutAt cuts the parent into a head and a tail, reporting whether the parent
+// was cut
+func (m *MessyCrossover) cutAt(parent []int, rng generator.IGenerator) ([]int, []int, bool) {
+	if len(parent) > 1 && rng.Float64() < m.cut*float64(len(parent)-1) {
+		point := 1 + rng.Intn(len(parent)-1)
+		return parent[:point], parent[point:], true
+	}
+	return parent, nil, false
+}
+
+// NewMessyCrossover creates a new instance of the MessyCrossover
+func NewMessyCrossover(cut float64, splice float64) ICrossover {
+	m := &MessyCrossover{}
+	m.SetCut(cut)
+	m.SetSplice(splice)
+	return m
+}
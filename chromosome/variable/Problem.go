@@ -0,0 +1,60 @@
+package variable
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/problem"
+)
+
+// Problem is the base struct for problems over variable length sequences.
+// It generates chromosomes with its alphabet, initial lengths and operators
+// so that a new problem which embeds it only needs to implement the
+// ObjectiveFunction. The dimensions of the problem are the length of the
+// generated chromosomes unless initial lengths are set, and the solver
+// enforces the limits of the length during the run.
+type Problem struct {
+	problem.Problem
+	alphabet      int
+	minInitLength int
+	maxInitLength int
+	mutation      IMutation
+	crossover     ICrossover
+}
+
+// SetAlphabet sets the number of distinct values of a gene
+func (p *Problem) SetAlphabet(alphabet int) {
+	p.alphabet = alphabet
+}
+
+// SetInitialLengths sets the range of lengths of generated chromosomes
+func (p *Problem) SetInitialLengths(minLength int, maxLength int) {
+	p.minInitLength = minLength
+	p.maxInitLength = maxLength
+}
+
+// SetMutation sets the mutation operator given to generated chromosomes
+func (p *Problem) SetMutation(mutation IMutation) {
+	p.mutation = mutation
+}
+
+// SetCrossover sets the crossover operator given to generated chromosomes
+func (p *Problem) SetCrossover(crossover ICrossover) {
+	p.crossover = crossover
+}
+
+// GetAlphabet returns the number of distinct values of a gene
+func (p *Problem) GetAlphabet() int {
+	return p.alphabet
+}
+
+// GenerateChromosome creates a new variable length Chromosome
+func (p *Problem) GenerateChromosome() chromosome.IChromosome {
+	chr := NewChromosome(p.GetDimensions(), p.DeriveGenerator(), p.alphabet).(*Chromosome)
+	chr.SetInitialLengths(p.minInitLength, p.maxInitLength)
+	if p.mutation != nil {
+		chr.SetMutation(p.mutation)
+	}
+	if p.crossover != nil {
+		chr.SetCrossover(p.crossover)
+	}
+	return chr
+}
@@ -0,0 +1,23 @@
+package variable
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// ResetMutation replaces a gene with a random gene, keeping the length
+type ResetMutation struct{}
+
+// Mutate applies the mutation to the phenotype
+func (m *ResetMutation) Mutate(phenotype []int, probability float64, alphabet int, rng generator.IGenerator) []int {
+	for i := range phenotype {
+		if rng.Float64() < probability {
+			phenotype[i] = rng.Intn(alphabet)
+		}
+	}
+	return phenotype
+}
+
+// NewResetMutation creates a new instance of the ResetMutation
+func NewResetMutation() IMutation {
+	return &ResetMutation{}
+}
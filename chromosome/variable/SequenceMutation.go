@@ -0,0 +1,29 @@
+package variable
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// SequenceMutation applies several mutations one after the other with the
+// same probability
+type SequenceMutation struct {
+	mutations []IMutation
+}
+
+// GetMutations returns the mutations in the order they are applied
+func (m *SequenceMutation) GetMutations() []IMutation {
+	return m.mutations
+}
+
+// Mutate applies every mutation to the phenotype
+func (m *SequenceMutation) Mutate(phenotype []int, probability float64, alphabet int, rng generator.IGenerator) []int {
+	for _, mutation := range m.mutations {
+		phenotype = mutation.Mutate(phenotype, probability, alphabet, rng)
+	}
+	return phenotype
+}
+
+// NewSequenceMutation creates a new instance of the SequenceMutation
+func NewSequenceMutation(mutations ...IMutation) IMutation {
+	return &SequenceMutation{mutations: mutations}
+}
@@ -1,32 +1,22 @@
 package onemax
 
 import (
-	"time"
-
 	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/chromosome/binary"
 	"github.com/opticverge/goevolution/objective"
 	"github.com/opticverge/goevolution/problem"
 )
 
-// Problem represents the OneMax problem is a problem type which evaluates the
-// sum of the values in a Chromosome.
+// Problem represents the OneMax problem is a problem type which counts the
+// number of set bits in a binary Chromosome.
 type Problem struct {
-	problem.Problem
+	binary.Problem
 }
 
 // ObjectiveFunction evaluates the chromosome and sets the fitness
 func (p *Problem) ObjectiveFunction(chromo *chromosome.IChromosome) {
-	chromos := (*chromo).(*Chromosome)
-	fitness := 0
-	for _, val := range chromos.Phenotype {
-		fitness += val
-	}
-	chromos.SetFitness(float64(fitness))
-}
-
-// GenerateChromosome creates a new OneMax Chromosome
-func (p *Problem) GenerateChromosome() chromosome.IChromosome {
-	return NewChromosome(p.GetDimensions(), p.GetGenerator().Clone(time.Now().UnixNano()))
+	chromos := (*chromo).(*binary.Chromosome)
+	chromos.SetFitness(float64(chromos.Phenotype.Count()))
 }
 
 // NewProblem creates a new instance of the OneMax Problem
@@ -20,9 +20,12 @@ func main() {
 	populationSize := 100
 	epochs := 100
 
+	// the seed of the problem reproduces the run
+	seed := time.Now().UnixNano()
+
 	// Generate the one max problem
 	p := onemax.NewProblem()
-	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	p.SetGenerator(generator.NewRandomGenerator(seed))
 	p.SetDimensions(dimensions)
 
 	// create the generic solver for the one max problem
@@ -34,5 +37,5 @@ func main() {
 	// initiate the evolutionary process
 	bestChromosome := s.Run()
 
-	fmt.Println(bestChromosome.GetFitness(), bestChromosome.GetPhenotype())
+	fmt.Println(seed, bestChromosome.GetFitness(), bestChromosome.GetPhenotype())
 }
@@ -0,0 +1,34 @@
+package sphere
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/chromosome/real"
+	"github.com/opticverge/goevolution/objective"
+	"github.com/opticverge/goevolution/problem"
+)
+
+// Problem represents the Sphere problem which minimises the sum of the
+// squares of a real valued vector. The optimum is zero at the origin.
+type Problem struct {
+	real.Problem
+}
+
+// ObjectiveFunction evaluates the chromosome and sets the fitness
+func (p *Problem) ObjectiveFunction(chromo *chromosome.IChromosome) {
+	chromos := (*chromo).(*real.Chromosome)
+	fitness := 0.0
+	for _, val := range chromos.Phenotype {
+		fitness += val * val
+	}
+	chromos.SetFitness(fitness)
+}
+
+// NewProblem creates a new instance of the Sphere Problem where every
+// dimension lies within [-5.12, 5.12]
+func NewProblem(dimensions int) problem.IProblem {
+	p := &Problem{}
+	p.SetName("Sphere")
+	p.SetObjective(objective.Minimisation)
+	p.SetBounds(real.NewUniformBounds(dimensions, -5.12, 5.12, real.Reflect))
+	return p
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/opticverge/goevolution/examples/sphere"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/solver"
+)
+
+func main() {
+
+	// set some of the properties
+	dimensions := 10
+	populationSize := 50
+	epochs := 100
+
+	// the seed of the problem reproduces the run
+	seed := time.Now().UnixNano()
+
+	// Generate the sphere problem
+	p := sphere.NewProblem(dimensions)
+	p.SetGenerator(generator.NewRandomGenerator(seed))
+
+	// create the generic solver for the sphere problem
+	s := solver.NewSolver()
+	s.SetEpochs(epochs)
+	s.SetProblem(p)
+	s.SetPopulationSize(populationSize)
+
+	// initiate the evolutionary process
+	bestChromosome := s.Run()
+
+	fmt.Println(seed, bestChromosome.GetFitness(), bestChromosome.GetPhenotype())
+}
@@ -0,0 +1,82 @@
+package symbolicregression
+
+import (
+	"math"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/gp"
+	"github.com/opticverge/goevolution/objective"
+	"github.com/opticverge/goevolution/problem"
+)
+
+// Number is the only type of the arithmetic primitive set
+const Number gp.Type = "Number"
+
+// Problem represents the symbolic regression of the quartic polynomial
+// x^4 + x^3 + x^2 + x from twenty cases in [-1, 1]. The fitness is the sum of
+// the absolute errors, which is zero for an exact expression.
+type Problem struct {
+	gp.Problem
+	cases []float64
+}
+
+// ObjectiveFunction evaluates the chromosome and sets the fitness
+func (p *Problem) ObjectiveFunction(chromo *chromosome.IChromosome) {
+	chromos := (*chromo).(*gp.Chromosome)
+	fitness := 0.0
+	for _, x := range p.cases {
+		y := chromos.Phenotype.Evaluate(gp.Environment{"x": x}).(float64)
+		fitness += math.Abs(y - Target(x))
+	}
+	if math.IsNaN(fitness) {
+		fitness = math.Inf(1)
+	}
+	chromos.SetFitness(fitness)
+}
+
+// Target returns the value of the polynomial being regressed
+func Target(x float64) float64 {
+	return x*x*x*x + x*x*x + x*x + x
+}
+
+// NewArithmeticSet creates the primitive set of addition, subtraction,
+// multiplication and protected division over the variable x and ephemeral
+// constants in [-1, 1]
+func NewArithmeticSet() *gp.PrimitiveSet {
+	set := gp.NewPrimitiveSet(Number)
+	binary := []gp.Type{Number, Number}
+	set.AddFunction("+", Number, binary, func(args ...interface{}) interface{} {
+		return args[0].(float64) + args[1].(float64)
+	})
+	set.AddFunction("-", Number, binary, func(args ...interface{}) interface{} {
+		return args[0].(float64) - args[1].(float64)
+	})
+	set.AddFunction("*", Number, binary, func(args ...interface{}) interface{} {
+		return args[0].(float64) * args[1].(float64)
+	})
+	set.AddFunction("/", Number, binary, func(args ...interface{}) interface{} {
+		// protected division returns one when dividing by zero
+		if args[1].(float64) == 0 {
+			return 1.0
+		}
+		return args[0].(float64) / args[1].(float64)
+	})
+	set.AddVariable("x", Number)
+	set.AddEphemeral("c", Number, func(rng generator.IGenerator) interface{} {
+		return rng.FloatRange(-1, 1)
+	})
+	return set
+}
+
+// NewProblem creates a new instance of the SymbolicRegression Problem
+func NewProblem() problem.IProblem {
+	p := &Problem{}
+	p.SetName("Symbolic Regression")
+	p.SetObjective(objective.Minimisation)
+	p.SetPrimitiveSet(NewArithmeticSet())
+	for i := 0; i < 20; i++ {
+		p.cases = append(p.cases, -1+2*float64(i)/19)
+	}
+	return p
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/opticverge/goevolution/examples/symbolicregression"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/gp"
+	"github.com/opticverge/goevolution/mutation"
+	"github.com/opticverge/goevolution/solver"
+)
+
+func main() {
+
+	// set some of the properties
+	populationSize := 200
+	epochs := 50
+	cloneCount := 4
+
+	// the seed of the problem reproduces the run
+	seed := time.Now().UnixNano()
+
+	// Generate the symbolic regression problem
+	p := symbolicregression.NewProblem()
+	p.SetGenerator(generator.NewRandomGenerator(seed))
+
+	// create the generic solver, preferring smaller trees of equal fitness
+	// and cloning each parent a few times rather than once per chromosome
+	s := solver.NewSolver()
+	s.SetEpochs(epochs)
+	s.SetProblem(p)
+	s.SetPopulationSize(populationSize)
+	s.SetSelector(gp.NewLexicographicTournamentSelector(7))
+	s.SetMutationStrategy(mutation.NewRankExponentialStrategy(2.4, cloneCount))
+
+	// initiate the evolutionary process
+	bestChromosome := s.Run()
+
+	fmt.Println(seed, bestChromosome.GetFitness(), bestChromosome.GetPhenotype())
+}
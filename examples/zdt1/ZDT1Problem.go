@@ -0,0 +1,44 @@
+package zdt1
+
+import (
+	"math"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/chromosome/real"
+	"github.com/opticverge/goevolution/objective"
+	"github.com/opticverge/goevolution/problem"
+)
+
+// Problem represents the ZDT1 problem of Zitzler, Deb and Thiele, which
+// minimises two conflicting objectives over a real valued vector in [0, 1].
+// The Pareto front is f2 = 1 - sqrt(f1) where every gene but the first is
+// zero.
+type Problem struct {
+	real.Problem
+}
+
+// ObjectiveFunction evaluates the chromosome and sets its fitnesses
+func (p *Problem) ObjectiveFunction(chromo *chromosome.IChromosome) {
+	chromos := (*chromo).(*real.Chromosome)
+	x := chromos.Phenotype
+
+	g := 0.0
+	for _, val := range x[1:] {
+		g += val
+	}
+	g = 1 + 9*g/float64(len(x)-1)
+
+	f1 := x[0]
+	f2 := g * (1 - math.Sqrt(f1/g))
+	chromos.SetFitnesses([]float64{f1, f2})
+}
+
+// NewProblem creates a new instance of the ZDT1 Problem, which needs at least
+// 2 dimensions
+func NewProblem(dimensions int) problem.IProblem {
+	p := &Problem{}
+	p.SetName("ZDT1")
+	p.SetObjectives(objective.Minimisation, objective.Minimisation)
+	p.SetBounds(real.NewUniformBounds(dimensions, 0, 1, real.Clamp))
+	return p
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/opticverge/goevolution/examples/zdt1"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/solver/nsga2"
+)
+
+func main() {
+
+	// set some of the properties
+	dimensions := 30
+	populationSize := 100
+	epochs := 250
+
+	// the seed of the problem reproduces the run
+	seed := time.Now().UnixNano()
+
+	// Generate the ZDT1 problem
+	p := zdt1.NewProblem(dimensions)
+	p.SetGenerator(generator.NewRandomGenerator(seed))
+
+	// create the NSGA-II solver for the two objectives
+	s := nsga2.NewSolver()
+	s.SetEpochs(epochs)
+	s.SetProblem(p)
+	s.SetPopulationSize(populationSize)
+
+	// initiate the evolutionary process
+	s.Run()
+
+	// print the Pareto front as the trade-off between the objectives
+	fmt.Println(seed)
+	for _, chromo := range s.(*nsga2.Solver).GetFront() {
+		fmt.Println(chromo.GetFitnesses())
+	}
+}
@@ -0,0 +1,31 @@
+package generator
+
+// golden is the increment of the SplitMix64 generator
+const golden uint64 = 0x9e3779b97f4a7c15
+
+// DeriveSeed deterministically derives a new seed from a root seed and a path
+// of values, for example a generation and the position of a chromosome. The
+// same root seed and path always produce the same seed, while different paths
+// produce seeds that are statistically independent, so generators can be
+// created concurrently without depending on the time or on the order in which
+// goroutines are scheduled.
+func DeriveSeed(seed int64, path ...int64) int64 {
+	state := mix(uint64(seed) + golden)
+	for _, value := range path {
+		state = mix(state ^ mix(uint64(value)+golden))
+	}
+	return int64(state)
+}
+
+// Derive creates a new generator of the same type as the provided generator
+// seeded from its seed and the path. See DeriveSeed.
+func Derive(rng IGenerator, path ...int64) IGenerator {
+	return rng.Clone(DeriveSeed(rng.GetSeed(), path...))
+}
+
+// mix is the output function of the SplitMix64 generator
+func mix(z uint64) uint64 {
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
@@ -0,0 +1,146 @@
+package gp
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/generator"
+)
+
+// Chromosome represents a program tree built from a primitive set. Variation
+// which takes a tree beyond the limits is rejected, leaving the parent in
+// its place. The set, limits and operators are shared by every clone and
+// child of the chromosome.
+type Chromosome struct {
+	chromosome.Chromosome
+	Phenotype      *Node
+	set            *PrimitiveSet
+	limits         *Limits
+	initialisation IInitialisation
+	mutation       IMutation
+	crossover      ICrossover
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// SETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// SetPrimitiveSet sets the primitive set trees are built from
+func (c *Chromosome) SetPrimitiveSet(set *PrimitiveSet) {
+	c.set = set
+}
+
+// SetLimits sets the largest depth and size of the tree
+func (c *Chromosome) SetLimits(limits *Limits) {
+	c.limits = limits
+}
+
+// SetInitialisation sets how the tree is generated
+func (c *Chromosome) SetInitialisation(initialisation IInitialisation) {
+	c.initialisation = initialisation
+}
+
+// SetMutation sets the mutation operator of the chromosome
+func (c *Chromosome) SetMutation(mutation IMutation) {
+	c.mutation = mutation
+}
+
+// SetCrossover sets the crossover operator of the chromosome
+func (c *Chromosome) SetCrossover(crossover ICrossover) {
+	c.crossover = crossover
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// GETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// GetPrimitiveSet returns the primitive set trees are built from
+func (c *Chromosome) GetPrimitiveSet() *PrimitiveSet {
+	return c.set
+}
+
+// GetLimits returns the largest depth and size of the tree
+func (c *Chromosome) GetLimits() *Limits {
+	return c.limits
+}
+
+// GetPhenotype returns the tree of the chromosome
+func (c *Chromosome) GetPhenotype() interface{} {
+	return c.Phenotype
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// ICHROMOSOME IMPLEMENTATIONS ////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// Generate creates a new tree with the initialisation
+func (c *Chromosome) Generate() {
+	c.setTree(c.initialisation.Initialise(c.set, c.GetGenerator()))
+}
+
+// Mutate applies the mutation operator, keeping the tree when the mutated
+// tree breaks the limits
+func (c *Chromosome) Mutate(mutationProbability float64) {
+	if mutated := c.mutation.Mutate(c.Phenotype, mutationProbability, c.set, c.GetGenerator()); c.limits.Allows(mutated) {
+		c.setTree(mutated)
+	}
+}
+
+// Clone creates a new copy of the chromosome
+func (c *Chromosome) Clone(rng generator.IGenerator) chromosome.IChromosome {
+	return c.with(c.Phenotype.Clone(), rng)
+}
+
+// Crossover applies the crossover operator with the other chromosome and
+// returns the children, replacing any child which breaks the limits by a
+// copy of the corresponding parent
+func (c *Chromosome) Crossover(other chromosome.IChromosome, rng generator.IGenerator) []chromosome.IChromosome {
+
+	parents := []*Node{c.Phenotype, other.(*Chromosome).Phenotype}
+	trees := c.crossover.Crossover(parents[0], parents[1], rng)
+
+	children := make([]chromosome.IChromosome, len(trees))
+	for i, tree := range trees {
+		if !c.limits.Allows(tree) {
+			tree = parents[i%len(parents)].Clone()
+		}
+		children[i] = c.with(tree, rng)
+	}
+
+	return children
+}
+
+// with creates a chromosome with the same configuration holding the tree
+func (c *Chromosome) with(tree *Node, rng generator.IGenerator) *Chromosome {
+	chr := NewChromosome(rng, c.set).(*Chromosome)
+	chr.SetLimits(c.limits)
+	chr.SetInitialisation(c.initialisation)
+	chr.SetMutation(c.mutation)
+	chr.SetCrossover(c.crossover)
+	chr.setTree(tree)
+	return chr
+}
+
+// setTree sets the tree of the chromosome, whose size is the dimensions of
+// the chromosome
+func (c *Chromosome) setTree(tree *Node) {
+	c.Phenotype = tree
+	c.SetDimensions(tree.Size())
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// CONSTRUCTOR ////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// NewChromosome creates a new instance of a program tree Chromosome. By
+// default trees are created by ramped half and half with depths from 2 to 6,
+// limited to Koza's depth of 17, and varied by subtree mutation and subtree
+// crossover.
+func NewChromosome(rng generator.IGenerator, set *PrimitiveSet) chromosome.IChromosome {
+	chr := &Chromosome{}
+	chr.SetGenerator(rng)
+	chr.SetPrimitiveSet(set)
+	chr.SetLimits(NewLimits(DefaultMaxDepth, 0))
+	chr.SetInitialisation(NewRampedHalfAndHalf(2, 6))
+	chr.SetMutation(NewSubtreeMutation(4))
+	chr.SetCrossover(NewSubtreeCrossover(0.9))
+	return chr
+}
@@ -0,0 +1,25 @@
+package gp
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// HoistMutation replaces the tree, with the probability, by one of its own
+// subtrees returning the root type, which always shrinks the tree and so
+// counters bloat
+type HoistMutation struct{}
+
+// Mutate returns the mutated tree
+func (m *HoistMutation) Mutate(tree *Node, probability float64, set *PrimitiveSet, rng generator.IGenerator) *Node {
+	tree = tree.Clone()
+	if rng.Float64() >= probability {
+		return tree
+	}
+	candidates := ofType(points(tree), tree.Type())
+	return candidates[rng.Intn(len(candidates))].node
+}
+
+// NewHoistMutation creates a new instance of the HoistMutation
+func NewHoistMutation() IMutation {
+	return &HoistMutation{}
+}
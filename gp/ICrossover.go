@@ -0,0 +1,11 @@
+package gp
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// ICrossover represents the interface for crossovers of program trees. The
+// parents are never modified and the children returned are new trees.
+type ICrossover interface {
+	Crossover(first *Node, second *Node, rng generator.IGenerator) []*Node
+}
@@ -0,0 +1,12 @@
+package gp
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// IMutation represents the interface for mutations of program trees. The
+// tree given is never modified; the mutated tree is returned instead so that
+// the chromosome can reject it when it breaks the limits.
+type IMutation interface {
+	Mutate(tree *Node, probability float64, set *PrimitiveSet, rng generator.IGenerator) *Node
+}
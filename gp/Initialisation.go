@@ -0,0 +1,100 @@
+package gp
+
+import (
+	"fmt"
+
+	"github.com/opticverge/goevolution/generator"
+)
+
+// Full creates a tree returning the type where every branch reaches the
+// depth, as far as the primitive set allows
+func Full(set *PrimitiveSet, returns Type, depth int, rng generator.IGenerator) *Node {
+	if function := set.function(returns, rng); function != nil && depth > 0 {
+		return grow(set, function, depth, rng, Full)
+	}
+	return leaf(set, returns, depth, rng, Full)
+}
+
+// Grow creates a tree returning the type where every node above the depth
+// is chosen from all of the primitives, so that branches end at varying
+// depths
+func Grow(set *PrimitiveSet, returns Type, depth int, rng generator.IGenerator) *Node {
+	functions, terminals := set.GetFunctions(returns), set.GetTerminals(returns)
+	if depth > 0 && len(functions) > 0 {
+		if i := rng.Intn(len(functions) + len(terminals)); i < len(functions) {
+			return grow(set, functions[i], depth, rng, Grow)
+		}
+	}
+	return leaf(set, returns, depth, rng, Grow)
+}
+
+// method is the signature shared by Full and Grow
+type method func(set *PrimitiveSet, returns Type, depth int, rng generator.IGenerator) *Node
+
+// grow creates a node for the function with children built by the method
+func grow(set *PrimitiveSet, function *Function, depth int, rng generator.IGenerator, build method) *Node {
+	node := &Node{Function: function, Children: make([]*Node, function.Arity())}
+	for i, argument := range function.Arguments {
+		node.Children[i] = build(set, argument, depth-1, rng)
+	}
+	return node
+}
+
+// leaf creates a terminal of the type, or a function when the type has no
+// terminals, which lets the tree exceed the depth
+func leaf(set *PrimitiveSet, returns Type, depth int, rng generator.IGenerator, build method) *Node {
+	if node, ok := set.leaf(returns, rng); ok {
+		return node
+	}
+	if function := set.function(returns, rng); function != nil {
+		return grow(set, function, depth, rng, build)
+	}
+	panic(fmt.Sprintf("gp: no primitive returns the type %q", returns))
+}
+
+// IInitialisation represents the interface for creating the initial trees
+type IInitialisation interface {
+	Initialise(set *PrimitiveSet, rng generator.IGenerator) *Node
+}
+
+// RampedHalfAndHalf creates every tree with a depth drawn uniformly between
+// the minimum and maximum depth, using Full for half of the trees and Grow
+// for the other half. Drawing the depth and method per tree rather than per
+// population keeps the generation of each chromosome independent.
+type RampedHalfAndHalf struct {
+	minDepth int
+	maxDepth int
+}
+
+// SetDepths sets the range of the depths of the initial trees
+func (r *RampedHalfAndHalf) SetDepths(minDepth int, maxDepth int) {
+	r.minDepth = minDepth
+	r.maxDepth = maxDepth
+}
+
+// GetMinDepth returns the smallest depth of the initial trees
+func (r *RampedHalfAndHalf) GetMinDepth() int {
+	return r.minDepth
+}
+
+// GetMaxDepth returns the largest depth of the initial trees
+func (r *RampedHalfAndHalf) GetMaxDepth() int {
+	return r.maxDepth
+}
+
+// Initialise creates a tree returning the root type of the set
+func (r *RampedHalfAndHalf) Initialise(set *PrimitiveSet, rng generator.IGenerator) *Node {
+	depth := r.minDepth + rng.Intn(r.maxDepth-r.minDepth+1)
+	if rng.Float64() < 0.5 {
+		return Full(set, set.GetRoot(), depth, rng)
+	}
+	return Grow(set, set.GetRoot(), depth, rng)
+}
+
+// NewRampedHalfAndHalf creates a new instance of the RampedHalfAndHalf
+// initialisation
+func NewRampedHalfAndHalf(minDepth int, maxDepth int) IInitialisation {
+	r := &RampedHalfAndHalf{}
+	r.SetDepths(minDepth, maxDepth)
+	return r
+}
@@ -0,0 +1,69 @@
+package gp
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/objective"
+	"github.com/opticverge/goevolution/selection"
+)
+
+// LexicographicTournamentSelector is a tournament selector with
+// lexicographic parsimony pressure: fitness decides each tournament and the
+// smaller tree wins between chromosomes of equal fitness. This controls
+// bloat without trading fitness for size.
+type LexicographicTournamentSelector struct {
+	size int
+}
+
+// SetSize sets the number of chromosomes competing in each tournament
+func (t *LexicographicTournamentSelector) SetSize(size int) {
+	t.size = size
+}
+
+// GetSize returns the number of chromosomes competing in each tournament
+func (t *LexicographicTournamentSelector) GetSize() int {
+	return t.size
+}
+
+// Select runs a tournament for every chromosome to be selected
+func (t *LexicographicTournamentSelector) Select(population []chromosome.IChromosome, count int, obj objective.Objective, rng generator.IGenerator) []chromosome.IChromosome {
+
+	selected := make([]chromosome.IChromosome, count)
+
+	if len(population) == 0 {
+		return selected[:0]
+	}
+
+	for i := 0; i < count; i++ {
+		winner := population[rng.Intn(len(population))]
+		for j := 1; j < t.size; j++ {
+			challenger := population[rng.Intn(len(population))]
+			if Parsimonious(challenger, winner, obj) {
+				winner = challenger
+			}
+		}
+		selected[i] = winner
+	}
+
+	return selected
+}
+
+// Parsimonious reports whether the first chromosome is better than the
+// second, comparing the size of their trees when the fitness is equal.
+// Chromosomes which are not program trees are compared by fitness alone.
+func Parsimonious(a chromosome.IChromosome, b chromosome.IChromosome, obj objective.Objective) bool {
+	if a.GetFitness() != b.GetFitness() {
+		return obj.IsBetter(a.GetFitness(), b.GetFitness())
+	}
+	first, ok := a.(*Chromosome)
+	second, ok2 := b.(*Chromosome)
+	return ok && ok2 && first.Phenotype.Size() < second.Phenotype.Size()
+}
+
+// NewLexicographicTournamentSelector creates a new instance of the
+// LexicographicTournamentSelector
+func NewLexicographicTournamentSelector(size int) selection.ISelector {
+	t := &LexicographicTournamentSelector{}
+	t.SetSize(size)
+	return t
+}
@@ -0,0 +1,36 @@
+package gp
+
+// DefaultMaxDepth is the depth limit recommended by Koza
+const DefaultMaxDepth = 17
+
+// Limits holds the largest depth and size a tree may grow to. Variation
+// which produces a tree beyond either limit is rejected in favour of the
+// parent, and a limit of zero or less is no limit. The limits do not apply
+// to the initialisation, whose depths should lie within them.
+type Limits struct {
+	maxDepth int
+	maxSize  int
+}
+
+// GetMaxDepth returns the largest depth of a tree
+func (l *Limits) GetMaxDepth() int {
+	return l.maxDepth
+}
+
+// GetMaxSize returns the largest number of nodes in a tree
+func (l *Limits) GetMaxSize() int {
+	return l.maxSize
+}
+
+// Allows reports whether the tree lies within the limits
+func (l *Limits) Allows(tree *Node) bool {
+	if l.maxDepth > 0 && tree.Depth() > l.maxDepth {
+		return false
+	}
+	return l.maxSize <= 0 || tree.Size() <= l.maxSize
+}
+
+// NewLimits creates new Limits
+func NewLimits(maxDepth int, maxSize int) *Limits {
+	return &Limits{maxDepth: maxDepth, maxSize: maxSize}
+}
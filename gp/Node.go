@@ -0,0 +1,178 @@
+package gp
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/opticverge/goevolution/generator"
+)
+
+// Environment holds the values of the variables a tree is evaluated with
+type Environment map[string]interface{}
+
+// Node is a node of a program tree. It holds either a function together
+// with a child for each of its arguments, or a terminal together with its
+// value.
+type Node struct {
+	Function *Function
+	Terminal *Terminal
+	Value    interface{}
+	Children []*Node
+}
+
+// Type returns the type the node returns
+func (n *Node) Type() Type {
+	if n.Function != nil {
+		return n.Function.Returns
+	}
+	return n.Terminal.Returns
+}
+
+// IsTerminal reports whether the node is a leaf
+func (n *Node) IsTerminal() bool {
+	return n.Function == nil
+}
+
+// Evaluate computes the value of the tree in the environment
+func (n *Node) Evaluate(env Environment) interface{} {
+	if n.Function == nil {
+		if n.Terminal.Kind == Variable {
+			return env[n.Terminal.Name]
+		}
+		return n.Value
+	}
+
+	args := make([]interface{}, len(n.Children))
+	for i, child := range n.Children {
+		args[i] = child.Evaluate(env)
+	}
+	return n.Function.Apply(args...)
+}
+
+// Depth returns the length of the longest path from the node to a leaf,
+// where a single leaf has a depth of zero
+func (n *Node) Depth() int {
+	depth := 0
+	for _, child := range n.Children {
+		if d := child.Depth() + 1; d > depth {
+			depth = d
+		}
+	}
+	return depth
+}
+
+// Size returns the number of nodes in the tree
+func (n *Node) Size() int {
+	size := 1
+	for _, child := range n.Children {
+		size += child.Size()
+	}
+	return size
+}
+
+// Clone returns a deep copy of the tree. The primitives are shared.
+func (n *Node) Clone() *Node {
+	clone := &Node{Function: n.Function, Terminal: n.Terminal, Value: n.Value}
+	if n.Children != nil {
+		clone.Children = make([]*Node, len(n.Children))
+		for i, child := range n.Children {
+			clone.Children[i] = child.Clone()
+		}
+	}
+	return clone
+}
+
+// String returns the tree as an s-expression
+func (n *Node) String() string {
+	var builder strings.Builder
+	n.write(&builder)
+	return builder.String()
+}
+
+// write appends the s-expression of the tree to the builder
+func (n *Node) write(builder *strings.Builder) {
+	switch {
+	case n.Function != nil:
+		builder.WriteString("(")
+		builder.WriteString(n.Function.Name)
+		for _, child := range n.Children {
+			builder.WriteString(" ")
+			child.write(builder)
+		}
+		builder.WriteString(")")
+	case n.Terminal.Kind == Variable:
+		builder.WriteString(n.Terminal.Name)
+	default:
+		fmt.Fprint(builder, n.Value)
+	}
+}
+
+// point is the location of a node within a tree. The root has no parent.
+type point struct {
+	node   *Node
+	parent *Node
+	index  int
+	depth  int
+}
+
+// points returns the location of every node in the tree in prefix order
+func points(root *Node) []point {
+	var result []point
+	var walk func(node *Node, parent *Node, index int, depth int)
+	walk = func(node *Node, parent *Node, index int, depth int) {
+		result = append(result, point{node: node, parent: parent, index: index, depth: depth})
+		for i, child := range node.Children {
+			walk(child, node, i, depth+1)
+		}
+	}
+	walk(root, nil, 0, 0)
+	return result
+}
+
+// replace puts the node at the point and returns the root of the tree
+func replace(root *Node, at point, node *Node) *Node {
+	if at.parent == nil {
+		return node
+	}
+	at.parent.Children[at.index] = node
+	return root
+}
+
+// choose returns a random point, preferring the points of functions with the
+// internal probability when there are any
+func choose(candidates []point, internal float64, rng generator.IGenerator) point {
+	var functions, terminals []point
+	for _, candidate := range candidates {
+		if candidate.node.IsTerminal() {
+			terminals = append(terminals, candidate)
+		} else {
+			functions = append(functions, candidate)
+		}
+	}
+
+	if len(functions) > 0 && (len(terminals) == 0 || rng.Float64() < internal) {
+		return functions[rng.Intn(len(functions))]
+	}
+	return terminals[rng.Intn(len(terminals))]
+}
+
+// ofType returns the points of nodes returning the type
+func ofType(candidates []point, returns Type) []point {
+	var result []point
+	for _, candidate := range candidates {
+		if candidate.node.Type() == returns {
+			result = append(result, candidate)
+		}
+	}
+	return result
+}
+
+// newTerminalNode creates a leaf for the terminal, drawing the value of an
+// ephemeral terminal
+func newTerminalNode(terminal *Terminal, rng generator.IGenerator) *Node {
+	node := &Node{Terminal: terminal, Value: terminal.Value}
+	if terminal.Kind == Ephemeral {
+		node.Value = terminal.Generate(rng)
+	}
+	return node
+}
@@ -0,0 +1,56 @@
+package gp
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// PointMutation replaces every node, with the probability, by another
+// primitive of the same signature. Functions keep their children and
+// terminals are replaced by a terminal of the same type, so the shape of the
+// tree never changes.
+type PointMutation struct{}
+
+// Mutate returns the mutated tree
+func (m *PointMutation) Mutate(tree *Node, probability float64, set *PrimitiveSet, rng generator.IGenerator) *Node {
+	tree = tree.Clone()
+	for _, at := range points(tree) {
+		if rng.Float64() >= probability {
+			continue
+		}
+
+		node := at.node
+		if node.IsTerminal() {
+			if leaf, ok := set.leaf(node.Type(), rng); ok {
+				*node = *leaf
+			}
+			continue
+		}
+
+		var candidates []*Function
+		for _, function := range set.GetFunctions(node.Type()) {
+			if sameArguments(function, node.Function) {
+				candidates = append(candidates, function)
+			}
+		}
+		node.Function = candidates[rng.Intn(len(candidates))]
+	}
+	return tree
+}
+
+// sameArguments reports whether the functions accept the same arguments
+func sameArguments(a *Function, b *Function) bool {
+	if a.Arity() != b.Arity() {
+		return false
+	}
+	for i := range a.Arguments {
+		if a.Arguments[i] != b.Arguments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// NewPointMutation creates a new instance of the PointMutation
+func NewPointMutation() IMutation {
+	return &PointMutation{}
+}
@@ -0,0 +1,50 @@
+package gp
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// Type is the type of the value a primitive returns or accepts. Trees are
+// strongly typed, so a node may only be the child of a function which
+// accepts its type at that argument.
+type Type string
+
+// TerminalKind is a type which defines where the value of a terminal comes
+// from.
+type TerminalKind string
+
+const (
+	// Variable terminals are read from the environment the tree is
+	// evaluated in
+	Variable TerminalKind = "Variable"
+
+	// Constant terminals always hold the same value
+	Constant TerminalKind = "Constant"
+
+	// Ephemeral terminals draw a random constant when they are placed in a
+	// tree, which then stays fixed for the life of that node
+	Ephemeral TerminalKind = "Ephemeral"
+)
+
+// Function is an internal node of a tree which computes its value from the
+// values of its children.
+type Function struct {
+	Name      string
+	Returns   Type
+	Arguments []Type
+	Apply     func(args ...interface{}) interface{}
+}
+
+// Arity returns the number of arguments of the function
+func (f *Function) Arity() int {
+	return len(f.Arguments)
+}
+
+// Terminal is a leaf of a tree.
+type Terminal struct {
+	Name     string
+	Returns  Type
+	Kind     TerminalKind
+	Value    interface{}
+	Generate func(rng generator.IGenerator) interface{}
+}
@@ -0,0 +1,131 @@
+package gp
+
+import (
+	"fmt"
+
+	"github.com/opticverge/goevolution/generator"
+)
+
+// PrimitiveSet holds the functions and terminals trees are built from,
+// grouped by the type they return, together with the type of the root.
+type PrimitiveSet struct {
+	root      Type
+	functions map[Type][]*Function
+	terminals map[Type][]*Terminal
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// SETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// SetRoot sets the type returned by the root of every tree
+func (s *PrimitiveSet) SetRoot(root Type) {
+	s.root = root
+}
+
+// AddFunction adds a function returning the type from arguments of the
+// given types
+func (s *PrimitiveSet) AddFunction(name string, returns Type, arguments []Type, apply func(args ...interface{}) interface{}) {
+	s.functions[returns] = append(s.functions[returns], &Function{
+		Name:      name,
+		Returns:   returns,
+		Arguments: arguments,
+		Apply:     apply,
+	})
+}
+
+// AddVariable adds a terminal whose value is read from the environment
+func (s *PrimitiveSet) AddVariable(name string, returns Type) {
+	s.terminals[returns] = append(s.terminals[returns], &Terminal{Name: name, Returns: returns, Kind: Variable})
+}
+
+// AddConstant adds a terminal which always holds the value
+func (s *PrimitiveSet) AddConstant(name string, returns Type, value interface{}) {
+	s.terminals[returns] = append(s.terminals[returns], &Terminal{Name: name, Returns: returns, Kind: Constant, Value: value})
+}
+
+// AddEphemeral adds a terminal which draws a random constant from generate
+// every time it is placed in a tree
+func (s *PrimitiveSet) AddEphemeral(name string, returns Type, generate func(rng generator.IGenerator) interface{}) {
+	s.terminals[returns] = append(s.terminals[returns], &Terminal{Name: name, Returns: returns, Kind: Ephemeral, Generate: generate})
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// GETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// GetRoot returns the type returned by the root of every tree
+func (s *PrimitiveSet) GetRoot() Type {
+	return s.root
+}
+
+// GetFunctions returns the functions which return the type
+func (s *PrimitiveSet) GetFunctions(returns Type) []*Function {
+	return s.functions[returns]
+}
+
+// GetTerminals returns the terminals which return the type
+func (s *PrimitiveSet) GetTerminals(returns Type) []*Terminal {
+	return s.terminals[returns]
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// BEHAVIOURS /////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// Validate checks that a tree can be completed from the root, which requires
+// a terminal for the root type and for every argument type of a function
+// that can appear in the tree
+func (s *PrimitiveSet) Validate() error {
+	visited := map[Type]bool{}
+	pending := []Type{s.root}
+	for len(pending) > 0 {
+		t := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		if visited[t] {
+			continue
+		}
+		visited[t] = true
+		if len(s.terminals[t]) == 0 {
+			return fmt.Errorf("gp: no terminal returns the type %q", t)
+		}
+		for _, function := range s.functions[t] {
+			pending = append(pending, function.Arguments...)
+		}
+	}
+	return nil
+}
+
+// leaf creates a node from a random terminal of the type, falling back to a
+// function when the type has no terminals
+func (s *PrimitiveSet) leaf(returns Type, rng generator.IGenerator) (*Node, bool) {
+	terminals := s.terminals[returns]
+	if len(terminals) == 0 {
+		return nil, false
+	}
+	return newTerminalNode(terminals[rng.Intn(len(terminals))], rng), true
+}
+
+// function returns a random function of the type or nil when there are none
+func (s *PrimitiveSet) function(returns Type, rng generator.IGenerator) *Function {
+	functions := s.functions[returns]
+	if len(functions) == 0 {
+		return nil
+	}
+	return functions[rng.Intn(len(functions))]
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// CONSTRUCTOR ////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// NewPrimitiveSet creates a new empty PrimitiveSet for trees returning the
+// root type
+func NewPrimitiveSet(root Type) *PrimitiveSet {
+	s := &PrimitiveSet{
+		functions: map[Type][]*Function{},
+		terminals: map[Type][]*Terminal{},
+	}
+	s.SetRoot(root)
+	return s
+}
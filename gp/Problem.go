@@ -0,0 +1,86 @@
+package gp
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/problem"
+)
+
+// Problem is the base struct for problems over program trees. It generates
+// chromosomes with its primitive set, limits and operators so that a new
+// problem which embeds it only needs to implement the ObjectiveFunction,
+// usually by evaluating the tree over a set of cases.
+type Problem struct {
+	problem.Problem
+	set            *PrimitiveSet
+	limits         *Limits
+	initialisation IInitialisation
+	mutation       IMutation
+	crossover      ICrossover
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// SETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// SetPrimitiveSet sets the primitive set trees are built from, giving the
+// problem a single dimension until a size limit is set
+func (p *Problem) SetPrimitiveSet(set *PrimitiveSet) {
+	p.set = set
+	if p.GetDimensions() == 0 {
+		p.SetDimensions(1)
+	}
+}
+
+// SetLimits sets the largest depth and size of the trees, where a positive
+// largest size is also the dimensions of the problem
+func (p *Problem) SetLimits(limits *Limits) {
+	p.limits = limits
+	switch {
+	case limits.GetMaxSize() > 0:
+		p.SetDimensions(limits.GetMaxSize())
+	case p.GetDimensions() == 0:
+		p.SetDimensions(1)
+	}
+}
+
+// SetInitialisation sets how the trees are generated
+func (p *Problem) SetInitialisation(initialisation IInitialisation) {
+	p.initialisation = initialisation
+}
+
+// SetMutation sets the mutation operator given to generated chromosomes
+func (p *Problem) SetMutation(mutation IMutation) {
+	p.mutation = mutation
+}
+
+// SetCrossover sets the crossover operator given to generated chromosomes
+func (p *Problem) SetCrossover(crossover ICrossover) {
+	p.crossover = crossover
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// GETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// GetPrimitiveSet returns the primitive set trees are built from
+func (p *Problem) GetPrimitiveSet() *PrimitiveSet {
+	return p.set
+}
+
+// GenerateChromosome creates a new program tree Chromosome
+func (p *Problem) GenerateChromosome() chromosome.IChromosome {
+	chr := NewChromosome(p.DeriveGenerator(), p.set).(*Chromosome)
+	if p.limits != nil {
+		chr.SetLimits(p.limits)
+	}
+	if p.initialisation != nil {
+		chr.SetInitialisation(p.initialisation)
+	}
+	if p.mutation != nil {
+		chr.SetMutation(p.mutation)
+	}
+	if p.crossover != nil {
+		chr.SetCrossover(p.crossover)
+	}
+	return chr
+}
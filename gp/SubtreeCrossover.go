@@ -0,0 +1,51 @@
+package gp
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// SubtreeCrossover exchanges a random subtree of one parent with a random
+// subtree of the same type in the other parent. Following Koza the crossover
+// points are functions with the internal probability, which avoids children
+// that only differ from their parents by a single leaf.
+type SubtreeCrossover struct {
+	internal float64
+}
+
+// SetInternal sets the probability of choosing a function as the crossover
+// point
+func (c *SubtreeCrossover) SetInternal(internal float64) {
+	c.internal = internal
+}
+
+// GetInternal returns the probability of choosing a function as the
+// crossover point
+func (c *SubtreeCrossover) GetInternal() float64 {
+	return c.internal
+}
+
+// Crossover produces two children from the parents. When the second parent
+// has no subtree of the type chosen in the first the children are copies of
+// the parents.
+func (c *SubtreeCrossover) Crossover(first *Node, second *Node, rng generator.IGenerator) []*Node {
+
+	left, right := first.Clone(), second.Clone()
+
+	at := choose(points(left), c.internal, rng)
+	candidates := ofType(points(right), at.node.Type())
+	if len(candidates) == 0 {
+		return []*Node{left, right}
+	}
+	with := choose(candidates, c.internal, rng)
+
+	left, right = replace(left, at, with.node), replace(right, with, at.node)
+
+	return []*Node{left, right}
+}
+
+// NewSubtreeCrossover creates a new instance of the SubtreeCrossover
+func NewSubtreeCrossover(internal float64) ICrossover {
+	c := &SubtreeCrossover{}
+	c.SetInternal(internal)
+	return c
+}
@@ -0,0 +1,39 @@
+package gp
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// SubtreeMutation replaces a random subtree, with the probability, by a new
+// tree of the same type created by Grow
+type SubtreeMutation struct {
+	depth int
+}
+
+// SetDepth sets the largest depth of the new subtrees
+func (m *SubtreeMutation) SetDepth(depth int) {
+	m.depth = depth
+}
+
+// GetDepth returns the largest depth of the new subtrees
+func (m *SubtreeMutation) GetDepth() int {
+	return m.depth
+}
+
+// Mutate returns the mutated tree
+func (m *SubtreeMutation) Mutate(tree *Node, probability float64, set *PrimitiveSet, rng generator.IGenerator) *Node {
+	tree = tree.Clone()
+	if rng.Float64() >= probability {
+		return tree
+	}
+	candidates := points(tree)
+	at := candidates[rng.Intn(len(candidates))]
+	return replace(tree, at, Grow(set, at.node.Type(), m.depth, rng))
+}
+
+// NewSubtreeMutation creates a new instance of the SubtreeMutation
+func NewSubtreeMutation(depth int) IMutation {
+	m := &SubtreeMutation{}
+	m.SetDepth(depth)
+	return m
+}
@@ -0,0 +1,36 @@
+package mutation
+
+// ConstantStrategy creates the same number of clones with the same mutation
+// probability for every parent.
+type ConstantStrategy struct {
+	cloneCount  int
+	probability float64
+}
+
+// SetCloneCount sets the number of clones created for each parent
+func (c *ConstantStrategy) SetCloneCount(cloneCount int) {
+	c.cloneCount = cloneCount
+}
+
+// SetProbability sets the mutation probability
+func (c *ConstantStrategy) SetProbability(probability float64) {
+	c.probability = probability
+}
+
+// CloneCount returns the number of clones created for each parent
+func (c *ConstantStrategy) CloneCount(state State) int {
+	return c.cloneCount
+}
+
+// MutationProbability returns the mutation probability
+func (c *ConstantStrategy) MutationProbability(state State) float64 {
+	return c.probability
+}
+
+// NewConstantStrategy creates a new instance of the ConstantStrategy
+func NewConstantStrategy(cloneCount int, probability float64) IMutationStrategy {
+	c := &ConstantStrategy{}
+	c.SetCloneCount(cloneCount)
+	c.SetProbability(probability)
+	return c
+}
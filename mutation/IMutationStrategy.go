@@ -0,0 +1,18 @@
+package mutation
+
+// IMutationStrategy represents the interface for strategies which control
+// how a chromosome is mutated by the solver. For every parent the solver asks
+// the strategy how many clones to create and the probability with which each
+// gene of a clone is mutated.
+type IMutationStrategy interface {
+	CloneCount(State) int
+	MutationProbability(State) float64
+}
+
+// IAdaptiveMutationStrategy is implemented by strategies which learn from the
+// outcome of their mutations. The solver reports whether the best clone of a
+// parent improved upon the parent once the clones have been evaluated.
+type IAdaptiveMutationStrategy interface {
+	IMutationStrategy
+	Feedback(State, bool)
+}
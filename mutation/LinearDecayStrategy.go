@@ -0,0 +1,68 @@
+package mutation
+
+import (
+	"math"
+)
+
+// LinearDecayStrategy decreases the mutation probability linearly from a
+// start value to an end value over a number of generations, favouring
+// exploration early in the run and exploitation later on.
+type LinearDecayStrategy struct {
+	start       float64
+	end         float64
+	generations int
+	cloneCount  int
+}
+
+// SetRange sets the mutation probability at the start and the end of the
+// decay
+func (l *LinearDecayStrategy) SetRange(start float64, end float64) {
+	l.start = start
+	l.end = end
+}
+
+// SetGenerations sets the number of generations over which the probability
+// decays. When zero or less the epochs of the solver are used instead.
+func (l *LinearDecayStrategy) SetGenerations(generations int) {
+	l.generations = generations
+}
+
+// SetCloneCount sets the number of clones created for each parent. A clone
+// count of zero or less creates as many clones as the size of the population.
+func (l *LinearDecayStrategy) SetCloneCount(cloneCount int) {
+	l.cloneCount = cloneCount
+}
+
+// CloneCount returns the number of clones created for each parent
+func (l *LinearDecayStrategy) CloneCount(state State) int {
+	if l.cloneCount <= 0 {
+		return state.PopulationSize
+	}
+	return l.cloneCount
+}
+
+// MutationProbability returns the mutation probability for the generation
+func (l *LinearDecayStrategy) MutationProbability(state State) float64 {
+
+	generations := l.generations
+	if generations <= 0 {
+		generations = state.Epochs
+	}
+
+	// without a horizon there is nothing to decay towards
+	if generations <= 0 {
+		return l.start
+	}
+
+	progress := math.Min(float64(state.Generation)/float64(generations), 1.0)
+	return l.start + (l.end-l.start)*progress
+}
+
+// NewLinearDecayStrategy creates a new instance of the LinearDecayStrategy
+func NewLinearDecayStrategy(start float64, end float64, generations int, cloneCount int) IMutationStrategy {
+	l := &LinearDecayStrategy{}
+	l.SetRange(start, end)
+	l.SetGenerations(generations)
+	l.SetCloneCount(cloneCount)
+	return l
+}
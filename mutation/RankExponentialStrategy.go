@@ -0,0 +1,50 @@
+package mutation
+
+import (
+	"math"
+)
+
+// RankExponentialStrategy mutates the best chromosomes gently and the worst
+// chromosomes heavily. The probability grows exponentially with the rank of
+// the parent from exp(-rate) for the best parent towards 1 for the worst.
+type RankExponentialStrategy struct {
+	rate       float64
+	cloneCount int
+}
+
+// SetRate sets the rate of the exponential
+func (r *RankExponentialStrategy) SetRate(rate float64) {
+	r.rate = rate
+}
+
+// SetCloneCount sets the number of clones created for each parent. A clone
+// count of zero or less creates as many clones as the size of the population.
+func (r *RankExponentialStrategy) SetCloneCount(cloneCount int) {
+	r.cloneCount = cloneCount
+}
+
+// CloneCount returns the number of clones created for each parent
+func (r *RankExponentialStrategy) CloneCount(state State) int {
+	if r.cloneCount <= 0 {
+		return state.PopulationSize
+	}
+	return r.cloneCount
+}
+
+// MutationProbability returns the mutation probability for the rank of the
+// parent
+func (r *RankExponentialStrategy) MutationProbability(state State) float64 {
+	if state.PopulationSize <= 0 {
+		return math.Exp(-r.rate)
+	}
+	return math.Exp(-r.rate * float64(state.PopulationSize-state.Rank) / float64(state.PopulationSize))
+}
+
+// NewRankExponentialStrategy creates a new instance of the
+// RankExponentialStrategy
+func NewRankExponentialStrategy(rate float64, cloneCount int) IMutationStrategy {
+	r := &RankExponentialStrategy{}
+	r.SetRate(rate)
+	r.SetCloneCount(cloneCount)
+	return r
+}
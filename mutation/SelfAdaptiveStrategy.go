@@ -0,0 +1,117 @@
+package mutation
+
+import (
+	"math"
+	"sync"
+)
+
+// SelfAdaptiveStrategy adapts its own mutation probability from the success
+// of previous mutations following the one-fifth success rule. At the start of
+// each generation the probability is increased by the factor when more than
+// a fifth of the mutations in the previous generation improved upon their
+// parent and decreased otherwise. Outcomes are only counted within a
+// generation so the result does not depend on the order in which parents
+// are mutated.
+type SelfAdaptiveStrategy struct {
+	probability float64
+	min         float64
+	max         float64
+	factor      float64
+	cloneCount  int
+
+	generation int
+	successes  int
+	trials     int
+	mutex      sync.Mutex
+}
+
+// SetProbability sets the current mutation probability
+func (s *SelfAdaptiveStrategy) SetProbability(probability float64) {
+	s.probability = probability
+}
+
+// SetBounds sets the lowest and highest mutation probability
+func (s *SelfAdaptiveStrategy) SetBounds(min float64, max float64) {
+	s.min = min
+	s.max = max
+}
+
+// SetFactor sets the factor by which the probability is adapted
+func (s *SelfAdaptiveStrategy) SetFactor(factor float64) {
+	s.factor = factor
+}
+
+// SetCloneCount sets the number of clones created for each parent. A clone
+// count of zero or less creates as many clones as the size of the population.
+func (s *SelfAdaptiveStrategy) SetCloneCount(cloneCount int) {
+	s.cloneCount = cloneCount
+}
+
+// GetProbability returns the current mutation probability
+func (s *SelfAdaptiveStrategy) GetProbability() float64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.probability
+}
+
+// CloneCount returns the number of clones created for each parent
+func (s *SelfAdaptiveStrategy) CloneCount(state State) int {
+	if s.cloneCount <= 0 {
+		return state.PopulationSize
+	}
+	return s.cloneCount
+}
+
+// MutationProbability returns the current mutation probability, adapting it
+// first when a new generation has started
+func (s *SelfAdaptiveStrategy) MutationProbability(state State) float64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if state.Generation != s.generation {
+		s.adapt()
+		s.generation = state.Generation
+	}
+
+	return s.probability
+}
+
+// Feedback records whether a mutation improved upon its parent
+func (s *SelfAdaptiveStrategy) Feedback(state State, improved bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.trials++
+	if improved {
+		s.successes++
+	}
+}
+
+// adapt applies the one-fifth success rule to the recorded outcomes
+func (s *SelfAdaptiveStrategy) adapt() {
+
+	if s.trials == 0 {
+		return
+	}
+
+	if float64(s.successes)/float64(s.trials) > 0.2 {
+		s.probability *= s.factor
+	} else {
+		s.probability /= s.factor
+	}
+
+	s.probability = math.Max(s.min, math.Min(s.max, s.probability))
+	s.successes = 0
+	s.trials = 0
+}
+
+// NewSelfAdaptiveStrategy creates a new instance of the SelfAdaptiveStrategy
+// starting from the given probability
+func NewSelfAdaptiveStrategy(probability float64, cloneCount int) IMutationStrategy {
+	s := &SelfAdaptiveStrategy{}
+	s.SetProbability(probability)
+	s.SetBounds(0.001, 1.0)
+	s.SetFactor(1.5)
+	s.SetCloneCount(cloneCount)
+	return s
+}
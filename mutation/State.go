@@ -0,0 +1,28 @@
+package mutation
+
+import (
+	"github.com/opticverge/goevolution/objective"
+)
+
+// State describes the parent being mutated and the population it belongs to
+// so that a strategy can base its decisions on them.
+type State struct {
+	// Rank is the position of the parent in the sorted population where 0 is
+	// the best
+	Rank int
+
+	// Generation is the current generation of the solver and Epochs is the
+	// maximum number of generations, or -1 when unbounded
+	Generation int
+	Epochs     int
+
+	PopulationSize int
+	Objective      objective.Objective
+
+	// Fitness is the fitness of the parent followed by the statistics of the
+	// population
+	Fitness      float64
+	BestFitness  float64
+	WorstFitness float64
+	MeanFitness  float64
+}
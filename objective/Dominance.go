@@ -0,0 +1,21 @@
+package objective
+
+// Dominates reports whether the fitnesses a Pareto dominate the fitnesses b,
+// meaning a is at least as good as b for every objective and strictly better
+// for at least one of them. Fitnesses with fewer values than there are
+// objectives never dominate nor are dominated.
+func Dominates(a []float64, b []float64, objectives []Objective) bool {
+	if len(a) < len(objectives) || len(b) < len(objectives) {
+		return false
+	}
+	better := false
+	for i, o := range objectives {
+		if o.IsBetter(b[i], a[i]) {
+			return false
+		}
+		if o.IsBetter(a[i], b[i]) {
+			better = true
+		}
+	}
+	return better
+}
@@ -14,3 +14,12 @@ const (
 	// evaluation function.
 	Minimisation Objective = "Minimisation"
 )
+
+// IsBetter reports whether the fitness a is strictly better than the fitness
+// b with respect to the objective.
+func (o Objective) IsBetter(a float64, b float64) bool {
+	if o == Maximisation {
+		return a > b
+	}
+	return a < b
+}
@@ -0,0 +1,55 @@
+package problem
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/opticverge/goevolution/chromosome"
+)
+
+// PanicError is the error returned when evaluating a chromosome panics
+type PanicError struct {
+	Value interface{}
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("objective function panicked: %v", e.Value)
+}
+
+// Evaluate evaluates the chromosome against the problem and sets its fitness.
+// Problems which implement IMultiEvaluator are evaluated through
+// EvaluateObjectives, those which implement IEvaluator through Evaluate and
+// all others through ObjectiveFunction. A panic during evaluation is recovered
+// and returned as a PanicError.
+func Evaluate(ctx context.Context, p IProblem, chromo *chromosome.IChromosome) (err error) {
+
+	defer func() {
+		if value := recover(); value != nil {
+			err = &PanicError{Value: value, Stack: debug.Stack()}
+		}
+	}()
+
+	if evaluator, ok := p.(IMultiEvaluator); ok {
+		fitnesses, err := evaluator.EvaluateObjectives(ctx, *chromo)
+		if err != nil {
+			return err
+		}
+		(*chromo).SetFitnesses(fitnesses)
+		return nil
+	}
+
+	if evaluator, ok := p.(IEvaluator); ok {
+		fitness, err := evaluator.Evaluate(ctx, *chromo)
+		if err != nil {
+			return err
+		}
+		(*chromo).SetFitness(fitness)
+		return nil
+	}
+
+	p.ObjectiveFunction(chromo)
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package problem
+
+import (
+	"context"
+
+	"github.com/opticverge/goevolution/chromosome"
+)
+
+// IEvaluator is implemented by problems whose evaluation of a chromosome can
+// fail. When a problem implements IEvaluator the solver calls Evaluate in
+// place of ObjectiveFunction and assigns the returned fitness itself. The
+// context is done when the run has been cancelled.
+type IEvaluator interface {
+	Evaluate(context.Context, chromosome.IChromosome) (float64, error)
+}
+
+// IMultiEvaluator is implemented by multi-objective problems whose evaluation
+// of a chromosome can fail. When a problem implements IMultiEvaluator the
+// solver calls EvaluateObjectives in place of ObjectiveFunction and assigns
+// the returned fitnesses, one for every objective, itself.
+type IMultiEvaluator interface {
+	EvaluateObjectives(context.Context, chromosome.IChromosome) ([]float64, error)
+}
@@ -27,5 +27,6 @@ type IProblem interface {
 	GetDimensions() int
 	GetName() string
 	GetObjective() objective.Objective
+	GetObjectives() []objective.Objective
 	GetGenerator() generator.IGenerator
 }
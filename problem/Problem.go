@@ -1,6 +1,8 @@
 package problem
 
 import (
+	"sync/atomic"
+
 	"github.com/opticverge/goevolution/generator"
 	"github.com/opticverge/goevolution/objective"
 )
@@ -14,6 +16,8 @@ type Problem struct {
 	generator  generator.IGenerator
 	dimensions int
 	objective  objective.Objective
+	objectives []objective.Objective
+	derived    atomic.Int64
 	IProblem
 }
 
@@ -36,6 +40,18 @@ func (p *Problem) SetName(name string) {
 // population of a solver will be ordered.
 func (p *Problem) SetObjective(objective objective.Objective) {
 	p.objective = objective
+	p.objectives = nil
+}
+
+// SetObjectives sets the direction of every objective of a multi-objective
+// problem, whose ObjectiveFunction sets the fitnesses of the chromosome in
+// the same order. The objective of the problem becomes that of the first
+// objective.
+func (p *Problem) SetObjectives(objectives ...objective.Objective) {
+	p.objectives = objectives
+	if len(objectives) > 0 {
+		p.objective = objectives[0]
+	}
 }
 
 // SetGenerator sets the generator to be used by the problem
@@ -52,6 +68,15 @@ func (p *Problem) GetObjective() objective.Objective {
 	return p.objective
 }
 
+// GetObjectives returns the direction of every objective of the problem, or
+// the objective alone when the problem has a single objective
+func (p *Problem) GetObjectives() []objective.Objective {
+	if len(p.objectives) == 0 {
+		return []objective.Objective{p.objective}
+	}
+	return p.objectives
+}
+
 // GetGenerator returns the generator used for this problem
 func (p *Problem) GetGenerator() generator.IGenerator {
 	return p.generator
@@ -66,3 +91,10 @@ func (p *Problem) GetName() string {
 func (p *Problem) GetDimensions() int {
 	return p.dimensions
 }
+
+// DeriveGenerator returns a new generator derived from the generator of the
+// problem and the number of generators derived so far, so that every
+// chromosome the problem generates has its own seed
+func (p *Problem) DeriveGenerator() generator.IGenerator {
+	return generator.Derive(p.generator, p.derived.Add(1))
+}
@@ -0,0 +1,45 @@
+package replacement
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/objective"
+)
+
+// ElitistReplacer carries the best few chromosomes of the population over to
+// the next generation unchanged and fills the remainder with the best of the
+// offspring.
+type ElitistReplacer struct {
+	elites int
+}
+
+// SetElites sets the number of chromosomes which are carried over
+func (r *ElitistReplacer) SetElites(elites int) {
+	r.elites = elites
+}
+
+// GetElites returns the number of chromosomes which are carried over
+func (r *ElitistReplacer) GetElites() int {
+	return r.elites
+}
+
+// Replace keeps the elites and fills the population with the best offspring
+func (r *ElitistReplacer) Replace(population []chromosome.IChromosome, offspring []chromosome.IChromosome, size int, obj objective.Objective, generate func(int) []chromosome.IChromosome) []chromosome.IChromosome {
+
+	elites := r.elites
+	if elites > size {
+		elites = size
+	}
+
+	survivors := make([]chromosome.IChromosome, 0, size)
+	survivors = append(survivors, truncate(sorted(population, obj), elites)...)
+	survivors = fill(survivors, offspring, size, obj)
+
+	return fill(survivors, population, size, obj)
+}
+
+// NewElitistReplacer creates a new instance of the ElitistReplacer
+func NewElitistReplacer(elites int) IReplacer {
+	r := &ElitistReplacer{}
+	r.SetElites(elites)
+	return r
+}
@@ -0,0 +1,27 @@
+package replacement
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/objective"
+)
+
+// GenerationalReplacer replaces the whole population with the offspring. When
+// there are more offspring than the size of the population the best of them
+// survive, so that children appended after the mutants compete on fitness
+// rather than on the order they were produced. When there are fewer offspring
+// than the size of the population the best of the population make up the
+// difference. A parent none of whose clones improved on it is its own
+// mutant, see MuCommaLambdaReplacer to discard it.
+type GenerationalReplacer struct{}
+
+// Replace returns the offspring as the next population
+func (g *GenerationalReplacer) Replace(population []chromosome.IChromosome, offspring []chromosome.IChromosome, size int, obj objective.Objective, generate func(int) []chromosome.IChromosome) []chromosome.IChromosome {
+	survivors := make([]chromosome.IChromosome, 0, size)
+	survivors = append(survivors, truncate(sorted(union(nil, offspring), obj), size)...)
+	return fill(survivors, population, size, obj)
+}
+
+// NewGenerationalReplacer creates a new instance of the GenerationalReplacer
+func NewGenerationalReplacer() IReplacer {
+	return &GenerationalReplacer{}
+}
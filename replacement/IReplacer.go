@@ -0,0 +1,24 @@
+package replacement
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/objective"
+)
+
+// IReplacer represents the interface for replacement strategies. A replacer
+// decides which of the current population and the offspring produced during
+// a generation survive into the next generation. It returns a population of
+// the requested size and may call generate to create new, evaluated
+// chromosomes.
+type IReplacer interface {
+	Replace(population []chromosome.IChromosome, offspring []chromosome.IChromosome, size int, obj objective.Objective, generate func(int) []chromosome.IChromosome) []chromosome.IChromosome
+}
+
+// IParentDiscardingReplacer is implemented by replacers which can discard
+// every parent, such as comma selection. When DiscardsParents returns true
+// the solver hands the replacer the best clone of every parent even when it
+// is worse than the parent, so that no parent survives through mutation.
+type IParentDiscardingReplacer interface {
+	IReplacer
+	DiscardsParents() bool
+}
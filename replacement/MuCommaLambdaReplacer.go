@@ -0,0 +1,22 @@
+package replacement
+
+// MuCommaLambdaReplacer implements the (mu,lambda) strategy where only the
+// best of the offspring survive and the parents are discarded. The strategy
+// expects at least as many offspring as the size of the population, should
+// there be fewer the best of the population make up the difference. It
+// replaces the population as the GenerationalReplacer does, but discards
+// the parents, so the solver hands it the best clone of every parent even
+// when it is worse than the parent.
+type MuCommaLambdaReplacer struct {
+	GenerationalReplacer
+}
+
+// DiscardsParents returns true, since no parent survives through mutation
+func (r *MuCommaLambdaReplacer) DiscardsParents() bool {
+	return true
+}
+
+// NewMuCommaLambdaReplacer creates a new instance of the MuCommaLambdaReplacer
+func NewMuCommaLambdaReplacer() IReplacer {
+	return &MuCommaLambdaReplacer{}
+}
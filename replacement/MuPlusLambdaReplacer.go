@@ -0,0 +1,20 @@
+package replacement
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/objective"
+)
+
+// MuPlusLambdaReplacer implements the (mu+lambda) strategy where the parents
+// compete with the offspring and the best of both survive.
+type MuPlusLambdaReplacer struct{}
+
+// Replace returns the best of the population and the offspring combined
+func (r *MuPlusLambdaReplacer) Replace(population []chromosome.IChromosome, offspring []chromosome.IChromosome, size int, obj objective.Objective, generate func(int) []chromosome.IChromosome) []chromosome.IChromosome {
+	return truncate(sorted(union(population, offspring), obj), size)
+}
+
+// NewMuPlusLambdaReplacer creates a new instance of the MuPlusLambdaReplacer
+func NewMuPlusLambdaReplacer() IReplacer {
+	return &MuPlusLambdaReplacer{}
+}
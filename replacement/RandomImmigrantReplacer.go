@@ -0,0 +1,48 @@
+package replacement
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/objective"
+)
+
+// RandomImmigrantReplacer uses an empiricist approach to keep the population
+// diverse. The offspring replace the population, after which the worst of
+// them are removed and replaced with newly generated chromosomes according to
+// the rate.
+type RandomImmigrantReplacer struct {
+	rate float64
+}
+
+// SetRate sets the proportion of the population replaced by immigrants
+func (r *RandomImmigrantReplacer) SetRate(rate float64) {
+	r.rate = rate
+}
+
+// GetRate returns the proportion of the population replaced by immigrants
+func (r *RandomImmigrantReplacer) GetRate() float64 {
+	return r.rate
+}
+
+// Replace removes the worst of the offspring and adds the immigrants
+func (r *RandomImmigrantReplacer) Replace(population []chromosome.IChromosome, offspring []chromosome.IChromosome, size int, obj objective.Objective, generate func(int) []chromosome.IChromosome) []chromosome.IChromosome {
+
+	// get the replacement count of the population
+	replaceCount := int(r.rate * float64(size))
+
+	// keep the best of the offspring, topping up from the population if
+	// there were not enough offspring
+	survivors := make([]chromosome.IChromosome, 0, size)
+	survivors = append(survivors, truncate(sorted(union(nil, offspring), obj), size-replaceCount)...)
+	survivors = fill(survivors, population, size-replaceCount, obj)
+
+	// generate the replacements
+	return append(survivors, generate(size-len(survivors))...)
+}
+
+// NewRandomImmigrantReplacer creates a new instance of the
+// RandomImmigrantReplacer
+func NewRandomImmigrantReplacer(rate float64) IReplacer {
+	r := &RandomImmigrantReplacer{}
+	r.SetRate(rate)
+	return r
+}
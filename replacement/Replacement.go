@@ -0,0 +1,58 @@
+package replacement
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/objective"
+)
+
+// sorted returns a copy of the chromosomes ordered from best to worst
+func sorted(chromosomes []chromosome.IChromosome, obj objective.Objective) []chromosome.IChromosome {
+	ordered := make(chromosome.Chromosomes, len(chromosomes))
+	copy(ordered, chromosomes)
+	ordered.Sort(obj)
+	return ordered
+}
+
+// union combines the population with the offspring. Offspring which are the
+// same chromosome as a member of the population, for example when a parent
+// survives mutation unchanged, are only included once.
+func union(population []chromosome.IChromosome, offspring []chromosome.IChromosome) []chromosome.IChromosome {
+
+	members := make(map[chromosome.IChromosome]bool, len(population))
+	combined := make([]chromosome.IChromosome, 0, len(population)+len(offspring))
+
+	for _, c := range population {
+		members[c] = true
+		combined = append(combined, c)
+	}
+
+	for _, c := range offspring {
+		if !members[c] {
+			members[c] = true
+			combined = append(combined, c)
+		}
+	}
+
+	return combined
+}
+
+// fill appends chromosomes from the candidates, best first, until the
+// survivors reach the size. Candidates that already survived are skipped.
+func fill(survivors []chromosome.IChromosome, candidates []chromosome.IChromosome, size int, obj objective.Objective) []chromosome.IChromosome {
+	remaining := union(survivors, candidates)[len(survivors):]
+	for _, c := range sorted(remaining, obj) {
+		if len(survivors) >= size {
+			break
+		}
+		survivors = append(survivors, c)
+	}
+	return survivors
+}
+
+// truncate returns at most size chromosomes from the front of the list
+func truncate(chromosomes []chromosome.IChromosome, size int) []chromosome.IChromosome {
+	if len(chromosomes) > size {
+		return chromosomes[:size]
+	}
+	return chromosomes
+}
@@ -0,0 +1,48 @@
+package replacement
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/objective"
+)
+
+// SteadyStateReplacer replaces only the worst few chromosomes of the
+// population with the best of the offspring, leaving the rest of the
+// population untouched between generations.
+type SteadyStateReplacer struct {
+	count int
+}
+
+// SetCount sets the number of chromosomes replaced in each generation
+func (r *SteadyStateReplacer) SetCount(count int) {
+	r.count = count
+}
+
+// GetCount returns the number of chromosomes replaced in each generation
+func (r *SteadyStateReplacer) GetCount() int {
+	return r.count
+}
+
+// Replace swaps the worst of the population for the best of the offspring
+func (r *SteadyStateReplacer) Replace(population []chromosome.IChromosome, offspring []chromosome.IChromosome, size int, obj objective.Objective, generate func(int) []chromosome.IChromosome) []chromosome.IChromosome {
+
+	// only offspring which are not already members of the population count
+	best := truncate(sorted(union(population, offspring)[len(population):], obj), r.count)
+
+	keep := size - len(best)
+	if keep < 0 {
+		keep = 0
+	}
+
+	survivors := make([]chromosome.IChromosome, 0, size)
+	survivors = append(survivors, truncate(sorted(population, obj), keep)...)
+	survivors = append(survivors, best...)
+
+	return fill(survivors, population, size, obj)
+}
+
+// NewSteadyStateReplacer creates a new instance of the SteadyStateReplacer
+func NewSteadyStateReplacer(count int) IReplacer {
+	r := &SteadyStateReplacer{}
+	r.SetCount(count)
+	return r
+}
@@ -0,0 +1,16 @@
+package selection
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/objective"
+)
+
+// ISelector represents the interface for parent selection strategies. A
+// selector chooses the requested number of chromosomes from the population,
+// with replacement, favouring chromosomes that are better with respect to the
+// objective. The generator is used for all random decisions so that the
+// selection is reproducible.
+type ISelector interface {
+	Select([]chromosome.IChromosome, int, objective.Objective, generator.IGenerator) []chromosome.IChromosome
+}
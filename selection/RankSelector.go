@@ -0,0 +1,67 @@
+package selection
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/objective"
+)
+
+// RankSelector implements linear rank selection. The probability of
+// selecting a chromosome depends on its rank rather than its fitness, where
+// the pressure, between 1 and 2, controls how strongly the best chromosome is
+// favoured over the worst. A pressure of 1 gives every chromosome the same
+// chance of selection.
+type RankSelector struct {
+	pressure float64
+}
+
+// SetPressure sets the selection pressure which must be between 1 and 2
+func (r *RankSelector) SetPressure(pressure float64) {
+	r.pressure = pressure
+}
+
+// GetPressure returns the selection pressure
+func (r *RankSelector) GetPressure() float64 {
+	return r.pressure
+}
+
+// Select ranks the population and samples from the linear rank distribution
+func (r *RankSelector) Select(population []chromosome.IChromosome, count int, obj objective.Objective, rng generator.IGenerator) []chromosome.IChromosome {
+
+	selected := make([]chromosome.IChromosome, count)
+
+	size := len(population)
+	if size == 0 {
+		return selected[:0]
+	}
+
+	// rank from worst to best without disturbing the callers population
+	ranked := make(chromosome.Chromosomes, size)
+	copy(ranked, population)
+	ranked.Sort(obj)
+
+	weights := make([]float64, size)
+	for i := 0; i < size; i++ {
+		rank := float64(size - 1 - i)
+		weights[i] = 2 - r.pressure
+		if size > 1 {
+			weights[i] += 2 * (r.pressure - 1) * rank / float64(size-1)
+		}
+	}
+
+	totals := cumulative(weights)
+	total := totals[size-1]
+
+	for i := 0; i < count; i++ {
+		selected[i] = ranked[search(totals, rng.Float64()*total)]
+	}
+
+	return selected
+}
+
+// NewRankSelector creates a new instance of the RankSelector
+func NewRankSelector(pressure float64) ISelector {
+	r := &RankSelector{}
+	r.SetPressure(pressure)
+	return r
+}
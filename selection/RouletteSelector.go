@@ -0,0 +1,37 @@
+package selection
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/objective"
+)
+
+// RouletteSelector implements fitness proportionate selection where the
+// probability of a chromosome being selected is proportional to how much
+// better it is than a point just below the worst chromosome in the
+// population.
+type RouletteSelector struct{}
+
+// Select spins the roulette wheel once for every chromosome to be selected
+func (r *RouletteSelector) Select(population []chromosome.IChromosome, count int, obj objective.Objective, rng generator.IGenerator) []chromosome.IChromosome {
+
+	selected := make([]chromosome.IChromosome, count)
+
+	if len(population) == 0 {
+		return selected[:0]
+	}
+
+	totals := cumulative(windowedWeights(population, obj))
+	total := totals[len(totals)-1]
+
+	for i := 0; i < count; i++ {
+		selected[i] = population[search(totals, rng.Float64()*total)]
+	}
+
+	return selected
+}
+
+// NewRouletteSelector creates a new instance of the RouletteSelector
+func NewRouletteSelector() ISelector {
+	return &RouletteSelector{}
+}
@@ -0,0 +1,92 @@
+package selection
+
+import (
+	"math"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/objective"
+)
+
+// windowedWeights converts the fitness of each chromosome into a non negative
+// weight where a higher weight is better. The fitness is windowed against a
+// point below the worst fitness in the population by the mean gap between
+// the fitnesses, so that the weights are valid for either objective and for
+// negative fitness values and every chromosome can be selected. When every
+// chromosome has the same fitness all of the weights are equal.
+//
+// Chromosomes without a finite fitness, such as those which failed to
+// evaluate, have a weight of 0 and the window is built from the finite
+// fitnesses alone, so the weights of the others are the same whether or not
+// any chromosome failed.
+func windowedWeights(population []chromosome.IChromosome, obj objective.Objective) []float64 {
+
+	weights := make([]float64, len(population))
+
+	best, worst := math.NaN(), math.NaN()
+	count := 0
+	for _, c := range population {
+		fitness := c.GetFitness()
+		if !finite(fitness) {
+			continue
+		}
+		if count == 0 || obj.IsBetter(fitness, best) {
+			best = fitness
+		}
+		if count == 0 || obj.IsBetter(worst, fitness) {
+			worst = fitness
+		}
+		count++
+	}
+
+	offset := 1.0
+	if count > 1 && best != worst {
+		offset = math.Abs(best-worst) / float64(count-1)
+	}
+
+	total := 0.0
+	for i, c := range population {
+		if finite(c.GetFitness()) {
+			weights[i] = math.Abs(c.GetFitness()-worst) + offset
+			total += weights[i]
+		}
+	}
+
+	if total == 0 {
+		for i := range weights {
+			weights[i] = 1
+		}
+	}
+
+	return weights
+}
+
+// finite reports whether the fitness is neither infinite nor NaN
+func finite(fitness float64) bool {
+	return !math.IsInf(fitness, 0) && !math.IsNaN(fitness)
+}
+
+// cumulative returns the running total of the weights
+func cumulative(weights []float64) []float64 {
+	totals := make([]float64, len(weights))
+	total := 0.0
+	for i, weight := range weights {
+		total += weight
+		totals[i] = total
+	}
+	return totals
+}
+
+// search returns the index of the first cumulative weight that is greater
+// than the value
+func search(totals []float64, value float64) int {
+	low, high := 0, len(totals)-1
+	for low < high {
+		mid := (low + high) / 2
+		if totals[mid] > value {
+			high = mid
+		} else {
+			low = mid + 1
+		}
+	}
+	return low
+}
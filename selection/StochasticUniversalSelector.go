@@ -0,0 +1,44 @@
+package selection
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/objective"
+)
+
+// StochasticUniversalSelector implements stochastic universal sampling. It
+// uses the same weights as the RouletteSelector but places evenly spaced
+// pointers over the wheel with a single spin, which removes the bias of
+// repeatedly spinning the wheel.
+type StochasticUniversalSelector struct{}
+
+// Select spins the wheel once and reads off count evenly spaced pointers
+func (s *StochasticUniversalSelector) Select(population []chromosome.IChromosome, count int, obj objective.Objective, rng generator.IGenerator) []chromosome.IChromosome {
+
+	selected := make([]chromosome.IChromosome, count)
+
+	if len(population) == 0 || count <= 0 {
+		return selected[:0]
+	}
+
+	totals := cumulative(windowedWeights(population, obj))
+	spacing := totals[len(totals)-1] / float64(count)
+	start := rng.Float64() * spacing
+
+	index := 0
+	for i := 0; i < count; i++ {
+		pointer := start + float64(i)*spacing
+		for index < len(totals)-1 && totals[index] <= pointer {
+			index++
+		}
+		selected[i] = population[index]
+	}
+
+	return selected
+}
+
+// NewStochasticUniversalSelector creates a new instance of the
+// StochasticUniversalSelector
+func NewStochasticUniversalSelector() ISelector {
+	return &StochasticUniversalSelector{}
+}
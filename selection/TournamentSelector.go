@@ -0,0 +1,54 @@
+package selection
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/objective"
+)
+
+// TournamentSelector selects each chromosome by sampling a number of
+// chromosomes from the population at random and choosing the best of them.
+// Larger tournaments increase the selection pressure.
+type TournamentSelector struct {
+	size int
+}
+
+// SetSize sets the number of chromosomes competing in each tournament
+func (t *TournamentSelector) SetSize(size int) {
+	t.size = size
+}
+
+// GetSize returns the number of chromosomes competing in each tournament
+func (t *TournamentSelector) GetSize() int {
+	return t.size
+}
+
+// Select runs a tournament for every chromosome to be selected
+func (t *TournamentSelector) Select(population []chromosome.IChromosome, count int, obj objective.Objective, rng generator.IGenerator) []chromosome.IChromosome {
+
+	selected := make([]chromosome.IChromosome, count)
+
+	if len(population) == 0 {
+		return selected[:0]
+	}
+
+	for i := 0; i < count; i++ {
+		winner := population[rng.Intn(len(population))]
+		for j := 1; j < t.size; j++ {
+			challenger := population[rng.Intn(len(population))]
+			if obj.IsBetter(challenger.GetFitness(), winner.GetFitness()) {
+				winner = challenger
+			}
+		}
+		selected[i] = winner
+	}
+
+	return selected
+}
+
+// NewTournamentSelector creates a new instance of the TournamentSelector
+func NewTournamentSelector(size int) ISelector {
+	t := &TournamentSelector{}
+	t.SetSize(size)
+	return t
+}
@@ -0,0 +1,23 @@
+package solver
+
+// EvaluationPolicy is a type which defines how a solver handles chromosomes
+// whose evaluation fails, either by returning an error or by panicking. The
+// zero value behaves as Abort.
+type EvaluationPolicy string
+
+const (
+	// AssignWorst gives the chromosome the worst possible fitness for the
+	// objective of the problem, which is negative infinity when maximising
+	// and positive infinity when minimising.
+	AssignWorst EvaluationPolicy = "AssignWorst"
+
+	// Retry evaluates the chromosome again up to the number of retries of
+	// the solver before assigning the worst fitness.
+	Retry EvaluationPolicy = "Retry"
+
+	// Discard removes the chromosome from the chromosomes being evaluated.
+	Discard EvaluationPolicy = "Discard"
+
+	// Abort ends the run and returns the error from RunContext.
+	Abort EvaluationPolicy = "Abort"
+)
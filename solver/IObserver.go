@@ -0,0 +1,38 @@
+package solver
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/stats"
+)
+
+// IObserver is the interface for observers of the lifecycle of a solver.
+// Observers are registered with AddObserver and are notified in the order
+// they were added. An observer may end a run early by calling Stop on the
+// solver it receives in OnInitialised.
+type IObserver interface {
+	OnInitialised(ISolver)
+	OnGenerationStart(int)
+	OnGenerationEnd(stats.Statistics)
+	OnNewBest(chromosome.IChromosome)
+	OnTerminated(string)
+}
+
+// Observer implements every callback of the IObserver interface without
+// doing anything. Embedding it into a new observer means only the callbacks
+// of interest need to be implemented.
+type Observer struct{}
+
+// OnInitialised is called once the initial population has been evaluated
+func (o *Observer) OnInitialised(solver ISolver) {}
+
+// OnGenerationStart is called before a generation is evolved
+func (o *Observer) OnGenerationStart(generation int) {}
+
+// OnGenerationEnd is called with the statistics of a completed generation
+func (o *Observer) OnGenerationEnd(statistics stats.Statistics) {}
+
+// OnNewBest is called whenever a better chromosome is found
+func (o *Observer) OnNewBest(best chromosome.IChromosome) {}
+
+// OnTerminated is called with the reason the run ended
+func (o *Observer) OnTerminated(reason string) {}
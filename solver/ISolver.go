@@ -1,8 +1,18 @@
 package solver
 
 import (
+	"context"
+	"time"
+
 	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/mutation"
 	"github.com/opticverge/goevolution/problem"
+	"github.com/opticverge/goevolution/replacement"
+	"github.com/opticverge/goevolution/selection"
+	"github.com/opticverge/goevolution/stats"
+	"github.com/opticverge/goevolution/termination"
+	"github.com/opticverge/goevolution/worker"
 )
 
 // ISolver interface encapsulates the behaviours required for a solver to
@@ -11,8 +21,10 @@ type ISolver interface {
 
 	// Functions which must be implemented by each Solver
 	Run() chromosome.IChromosome
+	RunContext(context.Context) (chromosome.IChromosome, error)
 	Evolve()
 	Mutate()
+	Crossover()
 	Replace()
 
 	// Functions that will be implemented in the base solver struct. This
@@ -21,18 +33,52 @@ type ISolver interface {
 	Initialise()
 	GenerateChromosome() chromosome.IChromosome
 	GenerateChromosomes(int) []chromosome.IChromosome
+	SelectChromosomes(int) []chromosome.IChromosome
 	SortChromosomes(*[]chromosome.IChromosome)
 	EvaluateChromosomes(*[]chromosome.IChromosome)
+	Terminated() bool
+	Validate() error
+	Stop(string)
 
 	// SETTERS
 	SetProblem(problem.IProblem)
 	SetEpochs(int)
 	SetPopulationSize(int)
+	SetCrossoverRate(float64)
+	SetLengthLimits(int, int)
+	SetSelector(selection.ISelector)
+	SetReplacer(replacement.IReplacer)
+	SetMutationStrategy(mutation.IMutationStrategy)
+	SetTermination(termination.ICriterion)
 	SetPopulation([]chromosome.IChromosome)
+	SetOffspring([]chromosome.IChromosome)
+	SetWorkers(int)
+	SetEvaluationPolicy(EvaluationPolicy)
+	SetEvaluationRetries(int)
+	SetDiversity(bool)
+	AddObserver(IObserver)
 
 	// GETTERS
 	GetGeneration() int
+	GetEpochs() int
+	GetCrossoverRate() float64
+	GetDiversity() bool
 	GetPopulation() []chromosome.IChromosome
+	GetPopulationSize() int
+	GetOffspring() []chromosome.IChromosome
+	GetProblem() problem.IProblem
+	GetEvaluations() int
+	GetElapsed() time.Duration
+	GetTerminationReason() string
+	GetError() error
+	GetMinLength() int
+	GetMaxLength() int
+	GetBest() chromosome.IChromosome
+	GetContext() context.Context
+	GetGenerator() generator.IGenerator
+	GetPool() worker.IPool
+	GetCurrentStatistics() stats.Statistics
+	GetStatistics() []stats.Statistics
 
 	// LIFECYCLE MANAGEMENT
 	Setup()
@@ -1,25 +1,79 @@
 package solver
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"math"
-	"sort"
+	"runtime"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/mutation"
 	"github.com/opticverge/goevolution/objective"
 	"github.com/opticverge/goevolution/problem"
+	"github.com/opticverge/goevolution/replacement"
+	"github.com/opticverge/goevolution/selection"
+	"github.com/opticverge/goevolution/stats"
+	"github.com/opticverge/goevolution/termination"
+	"github.com/opticverge/goevolution/worker"
+)
+
+// Streams separate the seeds derived for the different stages of the solver
+// so that no two generators created during a run share a seed.
+const (
+	streamSolver int64 = iota + 1
+	streamGenerate
+	streamMutate
+	streamCrossover
 )
 
 // Solver represents the structure necessary to evolve a set of solutions
 // against a problem. It implements most of the ISolver interface and acts
-// as the base solver for all solvers.
+// as the base solver for all solvers. A solver which embeds it sets itself as
+// the embedded ISolver so that the run calls its own stages.
 type Solver struct {
 	epochs         int
 	generation     int
 	population     []chromosome.IChromosome
+	offspring      []chromosome.IChromosome
 	populationSize int
+	crossoverRate  float64
+	minLength      int
+	maxLength      int
 	problem        problem.IProblem
+	selector       selection.ISelector
+	replacer       replacement.IReplacer
+
+	mutationStrategy mutation.IMutationStrategy
+	mutationState    mutation.State
+
+	termination       termination.ICriterion
+	terminationReason string
+	evaluations       atomic.Int64
+	startTime         time.Time
+
+	ctx       context.Context
+	best      chromosome.IChromosome
+	rng       generator.IGenerator
+	generated int64
+	pool      worker.IPool
+
+	evaluationPolicy  EvaluationPolicy
+	evaluationRetries int
+	cancel            context.CancelFunc
+	err               error
+	errMutex          sync.Mutex
+
+	observers    []IObserver
+	stopped      bool
+	stopReason   string
+	improvements int
+	statistics   []stats.Statistics
+	diversity    bool
 	ISolver
 }
 
@@ -42,11 +96,90 @@ func (s *Solver) SetPopulationSize(populationSize int) {
 	s.populationSize = populationSize
 }
 
+// SetCrossoverRate sets the probability that a pair of chromosomes will be
+// recombined during the crossover stage.
+func (s *Solver) SetCrossoverRate(crossoverRate float64) {
+	s.crossoverRate = crossoverRate
+}
+
+// SetLengthLimits sets the smallest and largest dimensions of variable length
+// chromosomes. Chromosomes implementing IVariableLength are resized into the
+// limits after they are generated, mutated or recombined. A limit of zero is
+// no limit.
+func (s *Solver) SetLengthLimits(minLength int, maxLength int) {
+	s.minLength = minLength
+	s.maxLength = maxLength
+}
+
+// SetSelector sets the strategy used to select parents for mutation and
+// crossover. When no selector is set every chromosome in the population is
+// used as a parent.
+func (s *Solver) SetSelector(selector selection.ISelector) {
+	s.selector = selector
+}
+
+// SetReplacer sets the strategy used to decide which of the population and
+// the offspring survive into the next generation.
+func (s *Solver) SetReplacer(replacer replacement.IReplacer) {
+	s.replacer = replacer
+}
+
+// SetMutationStrategy sets the strategy that decides how many clones are
+// created for each parent and how heavily they are mutated.
+func (s *Solver) SetMutationStrategy(mutationStrategy mutation.IMutationStrategy) {
+	s.mutationStrategy = mutationStrategy
+}
+
+// SetTermination sets an additional criterion for ending the run. The run
+// ends when either the epochs are reached or the criterion is met.
+func (s *Solver) SetTermination(criterion termination.ICriterion) {
+	s.termination = criterion
+}
+
+// SetWorkers sets the maximum number of goroutines shared by every stage of
+// the solver. Zero or less defaults to GOMAXPROCS and a single worker runs
+// every stage sequentially, which is useful for debugging.
+func (s *Solver) SetWorkers(workers int) {
+	s.pool = worker.NewPool(workers)
+}
+
+// SetEvaluationPolicy sets how the solver handles chromosomes whose
+// evaluation fails. See EvaluationPolicy. The solver aborts by default.
+func (s *Solver) SetEvaluationPolicy(policy EvaluationPolicy) {
+	s.evaluationPolicy = policy
+}
+
+// SetDiversity sets whether the statistics of every generation measure the
+// diversity of the population. It is off by default since it costs a
+// distance for every pair of chromosomes, which is significant for large
+// populations or long chromosomes.
+func (s *Solver) SetDiversity(diversity bool) {
+	s.diversity = diversity
+}
+
+// SetEvaluationRetries sets the number of times a failed evaluation is
+// retried under the Retry evaluation policy.
+func (s *Solver) SetEvaluationRetries(retries int) {
+	s.evaluationRetries = retries
+}
+
+// AddObserver registers an observer to be notified of the lifecycle of the
+// solver.
+func (s *Solver) AddObserver(observer IObserver) {
+	s.observers = append(s.observers, observer)
+}
+
 // SetPopulation sets an array of chromosomes as the population of the solver
 func (s *Solver) SetPopulation(population []chromosome.IChromosome) {
 	s.population = population
 }
 
+// SetOffspring sets the offspring of the current generation, which a solver
+// embedding the base uses to hand its offspring to the replacement stage
+func (s *Solver) SetOffspring(offspring []chromosome.IChromosome) {
+	s.offspring = offspring
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 // GETTERS ////////////////////////////////////////////////////////////////////
 ///////////////////////////////////////////////////////////////////////////////
@@ -56,11 +189,111 @@ func (s *Solver) GetGeneration() int {
 	return s.generation
 }
 
+// GetEpochs returns the max number of generations the solver runs for, or -1
+// when the run is unbounded
+func (s *Solver) GetEpochs() int {
+	return s.epochs
+}
+
+// GetCrossoverRate returns the probability that a pair of parents is
+// recombined
+func (s *Solver) GetCrossoverRate() float64 {
+	return s.crossoverRate
+}
+
+// GetDiversity returns whether the statistics measure the diversity of the
+// population
+func (s *Solver) GetDiversity() bool {
+	return s.diversity
+}
+
 // GetPopulation returns the population of chromosomes
 func (s *Solver) GetPopulation() []chromosome.IChromosome {
 	return s.population
 }
 
+// GetPopulationSize returns the number of chromosomes in the population
+func (s *Solver) GetPopulationSize() int {
+	return s.populationSize
+}
+
+// GetProblem returns the problem the solver is solving
+func (s *Solver) GetProblem() problem.IProblem {
+	return s.problem
+}
+
+// GetEvaluations returns the number of times the objective function has been
+// evaluated during the run.
+func (s *Solver) GetEvaluations() int {
+	return int(s.evaluations.Load())
+}
+
+// GetElapsed returns the time elapsed since the run started
+func (s *Solver) GetElapsed() time.Duration {
+	return time.Since(s.startTime)
+}
+
+// GetMinLength returns the smallest dimensions of variable length chromosomes
+func (s *Solver) GetMinLength() int {
+	return s.minLength
+}
+
+// GetMaxLength returns the largest dimensions of variable length chromosomes
+func (s *Solver) GetMaxLength() int {
+	return s.maxLength
+}
+
+// GetBest returns the best chromosome found so far during the run
+func (s *Solver) GetBest() chromosome.IChromosome {
+	return s.best
+}
+
+// GetGenerator returns the generator the solver uses for its own random
+// decisions such as selection and pairing. It is derived from the generator
+// of the problem when the run starts so that a run is reproducible from the
+// seed of the problem alone.
+func (s *Solver) GetGenerator() generator.IGenerator {
+	if s.rng == nil {
+		s.rng = generator.Derive(s.problem.GetGenerator(), streamSolver)
+	}
+	return s.rng
+}
+
+// GetPool returns the pool of workers used by the stages of the solver
+func (s *Solver) GetPool() worker.IPool {
+	if s.pool == nil {
+		s.SetWorkers(runtime.GOMAXPROCS(0))
+	}
+	return s.pool
+}
+
+// GetContext returns the context of the current run, defaulting to the
+// background context when the solver is not running.
+func (s *Solver) GetContext() context.Context {
+	if s.ctx == nil {
+		return context.Background()
+	}
+	return s.ctx
+}
+
+// GetError returns the error which aborted the last run, if any
+func (s *Solver) GetError() error {
+	s.errMutex.Lock()
+	defer s.errMutex.Unlock()
+	return s.err
+}
+
+// GetTerminationReason returns the reason the last run ended
+func (s *Solver) GetTerminationReason() string {
+	return s.terminationReason
+}
+
+// GetOffspring returns the offspring produced in the current generation which
+// are yet to be considered for replacement.
+func (s *Solver) GetOffspring() []chromosome.IChromosome {
+	return s.offspring
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 // INTERFACE METHODS //////////////////////////////////////////////////////////
 ///////////////////////////////////////////////////////////////////////////////
@@ -73,7 +306,9 @@ func (s *Solver) Initialise() {
 }
 
 // EvaluateChromosomes will evaluate the provided list of chromosomes
-// or default to evaluating the population of the solver
+// or default to evaluating the population of the solver. Failed evaluations
+// are handled according to the evaluation policy of the solver, where
+// discarded chromosomes are removed from the list.
 func (s *Solver) EvaluateChromosomes(chromosomes *[]chromosome.IChromosome) {
 
 	var chromosomesToEvaluate []chromosome.IChromosome
@@ -84,115 +319,495 @@ func (s *Solver) EvaluateChromosomes(chromosomes *[]chromosome.IChromosome) {
 		chromosomesToEvaluate = s.population
 	}
 
-	var wg sync.WaitGroup
+	ctx := s.GetContext()
+
+	discarded := make([]bool, len(chromosomesToEvaluate))
+
+	s.GetPool().Run(len(chromosomesToEvaluate), func(pos int) {
+
+		// chromosomes are left unevaluated once the run is cancelled
+		if ctx.Err() != nil {
+			return
+		}
+
+		discarded[pos] = !s.evaluateChromosome(ctx, &chromosomesToEvaluate[pos])
+	})
+
+	// remove the discarded chromosomes while preserving the order
+	kept := chromosomesToEvaluate[:0]
+	for i, c := range chromosomesToEvaluate {
+		if !discarded[i] {
+			kept = append(kept, c)
+		}
+	}
+
+	if len(kept) == len(chromosomesToEvaluate) {
+		return
+	}
+
+	if chromosomes != nil {
+		*chromosomes = kept
+	} else {
+		s.population = kept
+	}
+}
+
+// evaluateChromosome evaluates a single chromosome and applies the evaluation
+// policy when the evaluation fails. It returns false when the chromosome
+// should be discarded.
+func (s *Solver) evaluateChromosome(ctx context.Context, toEvaluate *chromosome.IChromosome) bool {
 
-	count := len(chromosomesToEvaluate)
-	for i := 0; i < count; i++ {
-		wg.Add(1)
-		go func(toEvaluate *chromosome.IChromosome) {
-			defer wg.Done()
-			s.problem.ObjectiveFunction(toEvaluate)
-		}(&chromosomesToEvaluate[i])
+	attempts := 1
+	if s.evaluationPolicy == Retry {
+		attempts += s.evaluationRetries
 	}
 
-	wg.Wait()
+	var err error
+	for attempt := 0; attempt < attempts; attempt++ {
+		err = problem.Evaluate(ctx, s.problem, toEvaluate)
+		s.evaluations.Add(1)
+		if err == nil || ctx.Err() != nil {
+			return true
+		}
+	}
+
+	switch s.evaluationPolicy {
+	case Discard:
+		return false
+	case Abort, "":
+		s.abort(err)
+	case AssignWorst, Retry:
+		// the failed chromosome is the worst for every objective so that
+		// multi-objective solvers can compare it too
+		objectives := s.problem.GetObjectives()
+		worst := make([]float64, len(objectives))
+		for i, o := range objectives {
+			if o == objective.Maximisation {
+				worst[i] = math.Inf(-1)
+			} else {
+				worst[i] = math.Inf(1)
+			}
+		}
+		if len(worst) > 1 {
+			(*toEvaluate).SetFitnesses(worst)
+		} else {
+			(*toEvaluate).SetFitness(worst[0])
+		}
+	}
+
+	return true
+}
+
+// abort records the first error of the run and cancels the run
+func (s *Solver) abort(err error) {
+	s.errMutex.Lock()
+	defer s.errMutex.Unlock()
+
+	if s.err != nil {
+		return
+	}
+
+	s.err = fmt.Errorf("evaluation failed: %w", err)
+
+	if s.cancel != nil {
+		s.cancel()
+	}
 }
 
 // GenerateChromosomes generates an array of chromosomes based on the value of
 // count. It presumes that the problem has implemented the GenerateChromosome
 // function since the problem is the specific component of the evolutionary
-// process.
+// process. Every chromosome is given its own generator derived from the
+// number of chromosomes generated so far in the run.
 func (s *Solver) GenerateChromosomes(count int) []chromosome.IChromosome {
 	chromosomes := make([]chromosome.IChromosome, count)
-	var wg sync.WaitGroup
-	for i := 0; i < count; i++ {
-		wg.Add(1)
-		go func(pos int, generatedChromosomes *[]chromosome.IChromosome) {
-			defer wg.Done()
-			generatedChromosome := s.problem.GenerateChromosome()
-			generatedChromosome.Generate()
-			(*generatedChromosomes)[pos] = generatedChromosome
-		}(i, &chromosomes)
-	}
-	wg.Wait()
+	offset := s.generated
+	s.generated += int64(count)
+	s.GetPool().Run(count, func(pos int) {
+		generatedChromosome := s.problem.GenerateChromosome()
+		generatedChromosome.SetGenerator(generator.Derive(s.problem.GetGenerator(), streamGenerate, offset+int64(pos)))
+		generatedChromosome.Generate()
+		s.limitLength(generatedChromosome)
+		chromosomes[pos] = generatedChromosome
+	})
 	return chromosomes
 }
 
+// limitLength resizes a variable length chromosome whose dimensions lie
+// outside of the length limits to the nearest limit
+func (s *Solver) limitLength(chromo chromosome.IChromosome) {
+	resizable, ok := chromo.(chromosome.IVariableLength)
+	if !ok {
+		return
+	}
+
+	switch dimensions := chromo.GetDimensions(); {
+	case s.minLength > 0 && dimensions < s.minLength:
+		resizable.Resize(s.minLength)
+	case s.maxLength > 0 && dimensions > s.maxLength:
+		resizable.Resize(s.maxLength)
+	}
+}
+
 // Run is the gateway to initialising the evolutionary optimisation process.
+// It does not return errors, so callers must check GetError afterwards: the
+// best chromosome is nil when the run could not start and the best found so
+// far when a failed evaluation aborted the run, and in both cases GetError
+// describes why. Use RunContext to receive the error directly.
 func (s *Solver) Run() chromosome.IChromosome {
+	best, _ := s.self().RunContext(context.Background())
+	return best
+}
+
+// RunContext runs the evolutionary optimisation process until it terminates
+// or the context is done. The context is checked between generations as well
+// as during evaluation and mutation. When the context is done the best
+// chromosome found so far is returned along with the error of the context.
+// When an evaluation fails under the Abort evaluation policy the run ends in
+// the same way and the evaluation error is returned instead.
+func (s *Solver) RunContext(ctx context.Context) (chromosome.IChromosome, error) {
+
+	// the stages are called on the solver embedding the base, if any
+	self := s.self()
+
+	// explain a misconfigured solver before anything can panic
+	if err := self.Validate(); err != nil {
+		s.err = err
+		s.terminationReason = err.Error()
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	s.ctx = ctx
+	s.cancel = cancel
+	defer func() {
+		s.ctx = nil
+		s.cancel = nil
+	}()
 
 	// prepares the solver
-	s.Setup()
+	self.Setup()
 
 	// initialises the population of chromosomes to be evolved
-	s.Initialise()
+	self.Initialise()
+	s.updateBest()
+	s.updateStatistics()
+
+	for _, observer := range s.observers {
+		observer.OnInitialised(self)
+	}
 
-	// evolves the chromosomes for the specified number of generations
-	for s.epochs == -1 || s.generation < s.epochs {
+	// evolves the chromosomes until the epochs are reached, the termination
+	// criterion is met or the context is done
+	for ctx.Err() == nil && !self.Terminated() {
 		s.generation++
-		s.Evolve()
+
+		for _, observer := range s.observers {
+			observer.OnGenerationStart(s.generation)
+		}
+
+		self.Evolve()
+		s.updateBest()
+
+		if statistics, ok := s.updateStatistics(); ok {
+			for _, observer := range s.observers {
+				observer.OnGenerationEnd(statistics)
+			}
+		}
+	}
+
+	err := s.GetError()
+
+	if err != nil {
+		s.terminationReason = err.Error()
+	} else if ctx.Err() != nil {
+		err = ctx.Err()
+		s.terminationReason = "context done: " + err.Error()
 	}
 
-	s.TearDown()
+	self.TearDown()
+
+	for _, observer := range s.observers {
+		observer.OnTerminated(s.terminationReason)
+	}
+
+	return s.best, err
+}
+
+// updateBest records the best chromosome of the population when it is better
+// than the best found so far. A generation interrupted by the context is
+// ignored since its chromosomes may not have been evaluated.
+func (s *Solver) updateBest() {
+
+	if s.GetContext().Err() != nil || len(s.population) == 0 {
+		return
+	}
 
 	s.SortChromosomes(nil)
 
-	return s.population[0]
+	if s.best == nil || s.problem.GetObjective().IsBetter(s.population[0].GetFitness(), s.best.GetFitness()) {
+		if s.best != nil {
+			s.improvements++
+		}
+
+		s.best = s.population[0]
+
+		for _, observer := range s.observers {
+			observer.OnNewBest(s.best)
+		}
+	}
+}
+
+// updateStatistics records the statistics of the current generation in the
+// history. A generation interrupted by the context is not recorded.
+func (s *Solver) updateStatistics() (stats.Statistics, bool) {
+
+	if s.GetContext().Err() != nil {
+		return stats.Statistics{}, false
+	}
+
+	statistics := s.GetCurrentStatistics()
+	s.statistics = append(s.statistics, statistics)
+
+	return statistics, true
+}
+
+// GetCurrentStatistics returns a snapshot of the statistics of the current
+// generation
+func (s *Solver) GetCurrentStatistics() stats.Statistics {
+	statistics := stats.NewFitnessStatistics(s.population, s.problem.GetObjective())
+	if s.diversity {
+		statistics.Diversity, statistics.HasDiversity = stats.Diversity(s.population)
+	}
+	statistics.Generation = s.generation
+	statistics.Evaluations = s.GetEvaluations()
+	statistics.Elapsed = s.GetElapsed()
+	statistics.Improvements = s.improvements
+	return statistics
+}
+
+// GetStatistics returns the statistics of every generation of the last run,
+// starting with the initial population.
+func (s *Solver) GetStatistics() []stats.Statistics {
+	return s.statistics
+}
+
+// Stop asks the solver to end the run at the end of the current generation
+// with the given reason. It is intended to be called by observers.
+func (s *Solver) Stop(reason string) {
+	s.stopped = true
+	s.stopReason = reason
 }
 
-// Evolve triggers the evolutionary process for mutation and replacement
+// Validate checks the configuration of the solver and returns every problem
+// found as a ValidationError, joined into a single error. It is called at the
+// start of every run.
+func (s *Solver) Validate() error {
+
+	var errs []error
+
+	invalid := func(field string, value interface{}, err error, reason string) {
+		errs = append(errs, &ValidationError{Field: field, Value: value, Reason: reason, Err: err})
+	}
+
+	if s.problem == nil {
+		invalid("problem", nil, ErrNoProblem, "call SetProblem before running the solver")
+	} else {
+		if s.problem.GetGenerator() == nil {
+			invalid("generator", nil, ErrNoGenerator, "call SetGenerator on the problem")
+		}
+		if s.problem.GetDimensions() < 1 {
+			invalid("dimensions", s.problem.GetDimensions(), ErrInvalidDimensions, "the problem needs at least 1 dimension")
+		}
+	}
+
+	if s.populationSize < 1 {
+		invalid("populationSize", s.populationSize, ErrInvalidPopulationSize, "the population needs at least 1 chromosome")
+	}
+
+	if s.epochs < -1 {
+		invalid("epochs", s.epochs, ErrInvalidEpochs, "use -1 to run until another criterion ends the run")
+	}
+
+	if s.crossoverRate < 0 || s.crossoverRate > 1 {
+		invalid("crossoverRate", s.crossoverRate, ErrInvalidRate, "the rate must be between 0 and 1")
+	}
+
+	if s.minLength < 0 || s.maxLength < 0 || (s.maxLength > 0 && s.minLength > s.maxLength) {
+		invalid("lengthLimits", [2]int{s.minLength, s.maxLength}, ErrInvalidLength, "the limits cannot be negative and the minimum cannot exceed the maximum")
+	}
+
+	switch policy := s.evaluationPolicy; policy {
+	case "", AssignWorst, Retry, Discard, Abort:
+	default:
+		invalid("evaluationPolicy", policy, ErrIncompatibleStrategy, "unknown evaluation policy")
+	}
+
+	if s.evaluationRetries < 0 {
+		invalid("evaluationRetries", s.evaluationRetries, ErrIncompatibleStrategy, "retries cannot be negative")
+	}
+
+	switch selector := s.selector.(type) {
+	case *selection.TournamentSelector:
+		if selector.GetSize() < 1 {
+			invalid("selector", selector.GetSize(), ErrIncompatibleStrategy, "tournaments need at least 1 chromosome")
+		}
+	case *selection.RankSelector:
+		if selector.GetPressure() < 1 || selector.GetPressure() > 2 {
+			invalid("selector", selector.GetPressure(), ErrIncompatibleStrategy, "rank selection pressure must be between 1 and 2")
+		}
+	}
+
+	switch replacer := s.replacer.(type) {
+	case *replacement.RandomImmigrantReplacer:
+		if replacer.GetRate() < 0 || replacer.GetRate() > 1 {
+			invalid("replacer", replacer.GetRate(), ErrInvalidRate, "the immigrant rate must be between 0 and 1")
+		} else if replacer.GetRate() > 0 && int(replacer.GetRate()*float64(s.populationSize)) == 0 {
+			invalid("replacer", replacer.GetRate(), ErrIncompatibleStrategy, fmt.Sprintf("a population of %v never receives an immigrant, increase the population or the rate", s.populationSize))
+		}
+	case *replacement.SteadyStateReplacer:
+		if replacer.GetCount() < 1 || replacer.GetCount() > s.populationSize {
+			invalid("replacer", replacer.GetCount(), ErrIncompatibleStrategy, "steady state must replace between 1 and the population size")
+		}
+	case *replacement.ElitistReplacer:
+		if replacer.GetElites() < 0 || replacer.GetElites() > s.populationSize {
+			invalid("replacer", replacer.GetElites(), ErrIncompatibleStrategy, "the elites must be between 0 and the population size")
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Terminated reports whether the run should end, recording the reason when it
+// should.
+func (s *Solver) Terminated() bool {
+
+	if s.stopped {
+		s.terminationReason = s.stopReason
+		return true
+	}
+
+	if s.epochs != -1 && s.generation >= s.epochs {
+		s.terminationReason = termination.NewMaxGenerationsCriterion(s.epochs).Reason()
+		return true
+	}
+
+	if s.termination != nil && s.termination.Met(s) {
+		s.terminationReason = s.termination.Reason()
+		return true
+	}
+
+	return false
+}
+
+// Evolve triggers the evolutionary process for mutation, crossover and
+// replacement
 func (s *Solver) Evolve() {
-	s.Mutate()
-	s.Replace()
+	self := s.self()
+	self.Mutate()
+	self.Crossover()
+	self.Replace()
 }
 
-// Mutate initiates the mutation process for all of the chromosomes in the
-// population
+// self returns the solver embedding the base when one has been set, so that
+// the base can call the stages it overrides
+func (s *Solver) self() ISolver {
+	if s.ISolver != nil {
+		return s.ISolver
+	}
+	return s
+}
+
+// SelectChromosomes selects count parents from the population using the
+// selector of the solver. Without a selector a copy of the population is
+// returned.
+func (s *Solver) SelectChromosomes(count int) []chromosome.IChromosome {
+
+	if s.selector == nil {
+		selected := make([]chromosome.IChromosome, len(s.population))
+		copy(selected, s.population)
+		return selected
+	}
+
+	return s.selector.Select(s.population, count, s.problem.GetObjective(), s.GetGenerator())
+}
+
+// Mutate initiates the mutation process for the parents selected from the
+// population and stores the mutants as the offspring of the generation. The
+// selected parents are sorted so that their rank reflects their fitness.
 func (s *Solver) Mutate() {
-	var wg sync.WaitGroup
-	for i := 0; i < s.populationSize; i++ {
-		wg.Add(1)
-		go func(sourceChromosome chromosome.IChromosome, pos int, population *[]chromosome.IChromosome) {
-			defer wg.Done()
-			(*population)[pos] = s.MutateChromosomes(sourceChromosome, pos)
-		}(s.population[i], i, &s.population)
+	parents := s.SelectChromosomes(s.populationSize)
+	s.SortChromosomes(&parents)
+
+	if s.mutationStrategy == nil {
+		s.mutationStrategy = mutation.NewRankExponentialStrategy(2.4, 0)
+	}
+
+	// capture the state of the population for the mutation strategy
+	s.mutationState = mutation.State{
+		Generation:     s.generation,
+		Epochs:         s.epochs,
+		PopulationSize: s.populationSize,
+		Objective:      s.problem.GetObjective(),
+	}
+	if len(parents) > 0 {
+		total := 0.0
+		for _, parent := range parents {
+			total += parent.GetFitness()
+		}
+		s.mutationState.BestFitness = parents[0].GetFitness()
+		s.mutationState.WorstFitness = parents[len(parents)-1].GetFitness()
+		s.mutationState.MeanFitness = total / float64(len(parents))
 	}
-	wg.Wait()
+
+	offspring := make([]chromosome.IChromosome, len(parents))
+
+	s.GetPool().Run(len(parents), func(pos int) {
+		offspring[pos] = s.MutateChromosomes(parents[pos], pos)
+	})
+
+	s.offspring = offspring
 }
 
 // MutateChromosomes generates mutations of the source chromosome.
 func (s *Solver) MutateChromosomes(sourceChromosome chromosome.IChromosome, rank int) chromosome.IChromosome {
 
-	// TODO: Allow for setting mutation strategy to determine clone count
-	cloneCount := s.populationSize //int(math.Max(float64((s.populationSize / (rank + 1))), 1.0))
+	state := s.mutationState
+	state.Rank = rank
+	state.Fitness = sourceChromosome.GetFitness()
 
-	// TODO: Calculate mutation probability independently
-	mutationProbability := math.Exp(-2.4 * float64((s.populationSize-rank)/s.populationSize))
+	// the mutation strategy determines the clone count and the probability
+	cloneCount := s.mutationStrategy.CloneCount(state)
+	mutationProbability := s.mutationStrategy.MutationProbability(state)
+
+	if cloneCount <= 0 || s.GetContext().Err() != nil {
+		return sourceChromosome
+	}
 
 	// placeholder for mutated chromosomes
 	clones := make([]chromosome.IChromosome, cloneCount)
 
-	var wg sync.WaitGroup
-
-	for i := 0; i < cloneCount; i++ {
-		wg.Add(1)
-		go func(source chromosome.IChromosome, pos int, sourceClones *[]chromosome.IChromosome) {
-			defer wg.Done()
-
-			// first we clone the chromsome and the selected generator from
-			// the problem
-			clonedGenerator := s.problem.GetGenerator().Clone(time.Now().UnixNano())
-			clone := source.Clone(clonedGenerator)
-			clone.Mutate(mutationProbability)
-			(*sourceClones)[pos] = clone
-		}(sourceChromosome, i, &clones)
-	}
+	s.GetPool().Run(cloneCount, func(pos int) {
 
-	wg.Wait()
+		// first we clone the chromsome and derive a generator for the
+		// clone from the generation, rank and position of the clone
+		clonedGenerator := generator.Derive(s.problem.GetGenerator(), streamMutate, int64(state.Generation), int64(rank), int64(pos))
+		clone := sourceChromosome.Clone(clonedGenerator)
+		clone.Mutate(mutationProbability)
+		s.limitLength(clone)
+		clones[pos] = clone
+	})
 
-	// when complete we evaluate the clones
+	// when complete we evaluate the clones, unless the run has been
+	// cancelled in the meantime
 	s.EvaluateChromosomes(&clones)
 
+	if s.GetContext().Err() != nil || len(clones) == 0 {
+		return sourceChromosome
+	}
+
 	// we then sort based on the objective function
 	s.SortChromosomes(&clones)
 
@@ -200,19 +815,72 @@ func (s *Solver) MutateChromosomes(sourceChromosome chromosome.IChromosome, rank
 	// replace the original chromosome if the best clone is better
 	bestChromosome := clones[0]
 
-	if s.problem.GetObjective() == objective.Maximisation {
-		if bestChromosome.GetFitness() < sourceChromosome.GetFitness() {
-			bestChromosome = sourceChromosome
-		}
-	} else {
-		if bestChromosome.GetFitness() > sourceChromosome.GetFitness() {
-			bestChromosome = sourceChromosome
-		}
+	if adaptive, ok := s.mutationStrategy.(mutation.IAdaptiveMutationStrategy); ok {
+		adaptive.Feedback(state, s.problem.GetObjective().IsBetter(bestChromosome.GetFitness(), sourceChromosome.GetFitness()))
+	}
+
+	// replacers such as comma selection discard the parents, so the best
+	// clone survives even when it is worse than its parent
+	if discarding, ok := s.replacer.(replacement.IParentDiscardingReplacer); ok && discarding.DiscardsParents() {
+		return bestChromosome
+	}
+
+	if s.problem.GetObjective().IsBetter(sourceChromosome.GetFitness(), bestChromosome.GetFitness()) {
+		bestChromosome = sourceChromosome
 	}
 
 	return bestChromosome
 }
 
+// Crossover pairs chromosomes in the population at random and recombines each
+// pair according to the crossover rate. The stage is skipped when the
+// chromosomes of the problem do not implement the ICrossover interface. The
+// children are evaluated and added to the offspring so that the replacement
+// stage can decide which chromosomes survive.
+func (s *Solver) Crossover() {
+
+	if s.crossoverRate <= 0 || len(s.population) < 2 || s.GetContext().Err() != nil {
+		return
+	}
+
+	if _, ok := s.population[0].(chromosome.ICrossover); !ok {
+		return
+	}
+
+	rng := s.GetGenerator()
+
+	// shuffle the selected parents so that they are paired at random
+	parents := s.SelectChromosomes(len(s.population))
+	order := rng.Permutation(len(parents))
+
+	var children []chromosome.IChromosome
+
+	for i := 0; i+1 < len(order); i += 2 {
+		if rng.Float64() >= s.crossoverRate {
+			continue
+		}
+
+		first := parents[order[i]].(chromosome.ICrossover)
+		second := parents[order[i+1]]
+
+		clonedGenerator := generator.Derive(s.problem.GetGenerator(), streamCrossover, int64(s.generation), int64(i))
+		children = append(children, first.Crossover(second, clonedGenerator)...)
+	}
+
+	if len(children) == 0 {
+		return
+	}
+
+	for _, child := range children {
+		s.limitLength(child)
+	}
+
+	// evaluate the children then add them to the offspring
+	s.EvaluateChromosomes(&children)
+
+	s.offspring = append(s.offspring, children...)
+}
+
 // SortChromosomes sorts a list of IChromosomes according to the objective of
 // the problem.
 func (s *Solver) SortChromosomes(chromosomes *[]chromosome.IChromosome) {
@@ -224,30 +892,31 @@ func (s *Solver) SortChromosomes(chromosomes *[]chromosome.IChromosome) {
 		chromosomesToSort = s.population
 	}
 
-	sort.Sort(chromosome.Chromosomes(chromosomesToSort))
-
-	if s.problem.GetObjective() == objective.Maximisation {
-		sort.Sort(sort.Reverse(chromosome.Chromosomes(chromosomesToSort)))
-	}
+	chromosome.Chromosomes(chromosomesToSort).Sort(s.problem.GetObjective())
 }
 
-// Replace uses an empiricist approach to remove the worst in the population
+// Replace hands the population and the offspring to the replacer of the
+// solver to form the next generation. When no replacer has been set the worst
+// 10% are replaced with random immigrants.
 func (s *Solver) Replace() {
 
-	// get the replacement count of the population
-	replaceCount := int(0.1 * float64(s.populationSize))
-
-	// remove the last 10%
-	s.population = s.population[0 : s.populationSize-replaceCount]
+	// keep the population of the last complete generation when cancelled
+	if s.GetContext().Err() != nil {
+		s.offspring = nil
+		return
+	}
 
-	// generate the replacements
-	newChromosomes := s.GenerateChromosomes(replaceCount)
+	if s.replacer == nil {
+		s.replacer = replacement.NewRandomImmigrantReplacer(0.1)
+	}
 
-	// sort the generated chromosomes
-	s.SortChromosomes(&newChromosomes)
+	s.population = s.replacer.Replace(s.population, s.offspring, s.populationSize, s.problem.GetObjective(), func(count int) []chromosome.IChromosome {
+		immigrants := s.GenerateChromosomes(count)
+		s.EvaluateChromosomes(&immigrants)
+		return immigrants
+	})
 
-	// add them to the population
-	s.population = append(s.population, newChromosomes...)
+	s.offspring = nil
 
 	// sort the population
 	s.SortChromosomes(nil)
@@ -258,6 +927,21 @@ func (s *Solver) Replace() {
 // opportunities for future integration tasks.
 func (s *Solver) Setup() {
 	s.generation = 1
+	s.evaluations.Store(0)
+	s.startTime = time.Now()
+	s.terminationReason = ""
+	s.best = nil
+	s.stopped = false
+	s.stopReason = ""
+	s.improvements = 0
+	s.statistics = nil
+	s.generated = 0
+	s.err = nil
+	s.rng = generator.Derive(s.problem.GetGenerator(), streamSolver)
+
+	if s.termination != nil {
+		s.termination.Reset()
+	}
 }
 
 // TearDown provides the opposite of what Setup provides.
@@ -265,5 +949,11 @@ func (s *Solver) TearDown() {}
 
 // NewSolver generates a new Solver which implements the ISolver interface
 func NewSolver() ISolver {
-	return &Solver{}
+	s := &Solver{}
+	s.SetCrossoverRate(0.9)
+	s.SetReplacer(replacement.NewRandomImmigrantReplacer(0.1))
+	s.SetMutationStrategy(mutation.NewRankExponentialStrategy(2.4, 0))
+	s.SetWorkers(runtime.GOMAXPROCS(0))
+	s.SetEvaluationPolicy(Abort)
+	return s
 }
@@ -0,0 +1,54 @@
+package solver
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNoProblem is returned when the solver has no problem to solve
+	ErrNoProblem = errors.New("no problem has been set")
+
+	// ErrNoGenerator is returned when the problem has no generator
+	ErrNoGenerator = errors.New("the problem has no generator")
+
+	// ErrInvalidDimensions is returned when the problem has no dimensions
+	ErrInvalidDimensions = errors.New("invalid dimensions")
+
+	// ErrInvalidPopulationSize is returned when the population is empty
+	ErrInvalidPopulationSize = errors.New("invalid population size")
+
+	// ErrInvalidEpochs is returned when the epochs are neither -1 nor
+	// a non negative number of generations
+	ErrInvalidEpochs = errors.New("invalid epochs")
+
+	// ErrInvalidRate is returned when a probability lies outside of [0, 1]
+	ErrInvalidRate = errors.New("invalid rate")
+
+	// ErrInvalidLength is returned when the length limits of variable
+	// length chromosomes cannot be met
+	ErrInvalidLength = errors.New("invalid length limits")
+
+	// ErrIncompatibleStrategy is returned when a strategy cannot work with
+	// the rest of the configuration of the solver
+	ErrIncompatibleStrategy = errors.New("incompatible strategy")
+)
+
+// ValidationError describes a single problem with the configuration of a
+// solver. It wraps one of the sentinel errors of this package so that
+// callers can test for the kind of problem with errors.Is.
+type ValidationError struct {
+	Field  string
+	Value  interface{}
+	Reason string
+	Err    error
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("solver: %v: %v = %v: %v", e.Err, e.Field, e.Value, e.Reason)
+}
+
+// Unwrap returns the sentinel error
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
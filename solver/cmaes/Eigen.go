@@ -0,0 +1,89 @@
+package cmaes
+
+import (
+	"math"
+)
+
+// eigen decomposes the symmetric matrix into its eigenvalues and the
+// eigenvectors stored as the columns of the second matrix, using the cyclic
+// Jacobi method. The matrix is left untouched.
+func eigen(matrix [][]float64) ([]float64, [][]float64) {
+
+	n := len(matrix)
+	a := identity(n)
+	v := identity(n)
+	for i := range a {
+		copy(a[i], matrix[i])
+	}
+
+	for sweep := 0; sweep < 50; sweep++ {
+
+		// stop once the off diagonal is negligible against the diagonal
+		off, diagonal := 0.0, 0.0
+		for i := 0; i < n; i++ {
+			diagonal += a[i][i] * a[i][i]
+			for j := i + 1; j < n; j++ {
+				off += a[i][j] * a[i][j]
+			}
+		}
+		if off <= 1e-24*diagonal || off == 0 {
+			break
+		}
+
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				if a[p][q] == 0 {
+					continue
+				}
+
+				// the rotation which zeroes a[p][q]
+				theta := (a[q][q] - a[p][p]) / (2 * a[p][q])
+				t := 1 / (math.Abs(theta) + math.Sqrt(theta*theta+1))
+				if theta < 0 {
+					t = -t
+				}
+				c := 1 / math.Sqrt(t*t+1)
+				s := t * c
+
+				for k := 0; k < n; k++ {
+					akp, akq := a[k][p], a[k][q]
+					a[k][p], a[k][q] = c*akp-s*akq, s*akp+c*akq
+				}
+				for k := 0; k < n; k++ {
+					apk, aqk := a[p][k], a[q][k]
+					a[p][k], a[q][k] = c*apk-s*aqk, s*apk+c*aqk
+				}
+				for k := 0; k < n; k++ {
+					vkp, vkq := v[k][p], v[k][q]
+					v[k][p], v[k][q] = c*vkp-s*vkq, s*vkp+c*vkq
+				}
+			}
+		}
+	}
+
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = a[i][i]
+	}
+
+	return values, v
+}
+
+// identity returns the identity matrix of size n
+func identity(n int) [][]float64 {
+	matrix := make([][]float64, n)
+	for i := range matrix {
+		matrix[i] = make([]float64, n)
+		matrix[i][i] = 1
+	}
+	return matrix
+}
+
+// norm returns the euclidean norm of the vector
+func norm(vector []float64) float64 {
+	total := 0.0
+	for _, value := range vector {
+		total += value * value
+	}
+	return math.Sqrt(total)
+}
@@ -0,0 +1,19 @@
+package cmaes
+
+// Restart is a type which defines what the solver does when the search
+// distribution has converged or stagnated.
+type Restart string
+
+const (
+	// NoRestart stops the run when the distribution converges
+	NoRestart Restart = "None"
+
+	// IPOP restarts from a random mean with the population size doubled
+	// each time (Auger and Hansen, 2005)
+	IPOP Restart = "IPOP"
+
+	// BIPOP alternates between restarts with a doubling population and
+	// restarts with a small population and step size, choosing whichever
+	// regime has used fewer evaluations (Hansen, 2009)
+	BIPOP Restart = "BIPOP"
+)
@@ -0,0 +1,519 @@
+package cmaes
+
+import (
+	"errors"
+	"math"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/chromosome/real"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/solver"
+)
+
+// Streams separate the seeds of the samples and restarts from the streams of
+// the base solver
+const (
+	streamSample int64 = iota + 201
+	streamRestart
+)
+
+// Solver implements the covariance matrix adaptation evolution strategy over
+// real valued chromosomes. Every generation samples the population from a
+// multivariate normal distribution, then moves the mean to the weighted mean
+// of the best half and adapts the step size by cumulative step-size
+// adaptation and the covariance matrix by the rank-one and rank-mu updates.
+// Samples outside the bounds of the problem are repaired by its policy
+// before they are evaluated and used for the update.
+type Solver struct {
+	solver.Solver
+	restart     Restart
+	sigmaFactor float64
+	maxRestarts int
+
+	// the restart regimes
+	defaultLambda int
+	largeLambda   int
+	largeBudget   int
+	smallBudget   int
+	regimeStart   int
+	regimeLarge   bool
+	restarts      int
+
+	// the search distribution
+	n        int
+	lambda   int
+	mu       int
+	weights  []float64
+	mueff    float64
+	cc       float64
+	cs       float64
+	c1       float64
+	cmu      float64
+	damps    float64
+	chiN     float64
+	mean     []float64
+	sigma    float64
+	sigma0   float64
+	pc       []float64
+	ps       []float64
+	c        [][]float64
+	b        [][]float64
+	d        []float64
+	invsqrtC [][]float64
+	eigenAt  int
+	g        int
+	bounds   *real.Bounds
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// SETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// SetRestart sets what the solver does when the distribution converges
+func (s *Solver) SetRestart(restart Restart) {
+	s.restart = restart
+}
+
+// SetMaxRestarts sets the number of restarts after which a converged
+// distribution stops the solver
+func (s *Solver) SetMaxRestarts(restarts int) {
+	s.maxRestarts = restarts
+}
+
+// SetSigma sets the initial step size as a proportion of the mean range of
+// the bounds
+func (s *Solver) SetSigma(sigma float64) {
+	s.sigmaFactor = sigma
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// GETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// GetRestart returns what the solver does when the distribution converges
+func (s *Solver) GetRestart() Restart {
+	return s.restart
+}
+
+// GetMaxRestarts returns the number of restarts after which a converged
+// distribution stops the solver
+func (s *Solver) GetMaxRestarts() int {
+	return s.maxRestarts
+}
+
+// GetRestarts returns the number of restarts of the current run
+func (s *Solver) GetRestarts() int {
+	return s.restarts
+}
+
+// GetMean returns the mean of the search distribution
+func (s *Solver) GetMean() []float64 {
+	return s.mean
+}
+
+// GetStepSize returns the current step size of the search distribution
+func (s *Solver) GetStepSize() float64 {
+	return s.sigma
+}
+
+// GetLambda returns the number of samples of the current generation
+func (s *Solver) GetLambda() int {
+	return s.lambda
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// ISOLVER IMPLEMENTATIONS ////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// Validate checks the configuration of the base solver and that the problem
+// generates bounded real valued chromosomes. A population size of zero
+// leaves the number of samples to the default of 4 + 3 ln(n) for the
+// dimensions of the problem.
+func (s *Solver) Validate() error {
+
+	errs := []error{s.withoutDefaultSize(s.Solver.Validate())}
+
+	if s.GetPopulationSize() == 1 {
+		errs = append(errs, &solver.ValidationError{Field: "populationSize", Value: s.GetPopulationSize(), Err: solver.ErrInvalidPopulationSize, Reason: "the strategy needs at least 2 samples"})
+	}
+
+	if s.sigmaFactor <= 0 {
+		errs = append(errs, &solver.ValidationError{Field: "sigma", Value: s.sigmaFactor, Err: solver.ErrIncompatibleStrategy, Reason: "the step size must be positive"})
+	}
+
+	if s.maxRestarts < 0 {
+		errs = append(errs, &solver.ValidationError{Field: "maxRestarts", Value: s.maxRestarts, Err: solver.ErrIncompatibleStrategy, Reason: "the number of restarts cannot be negative"})
+	}
+
+	switch s.restart {
+	case NoRestart, IPOP, BIPOP:
+	default:
+		errs = append(errs, &solver.ValidationError{Field: "restart", Value: s.restart, Err: solver.ErrIncompatibleStrategy, Reason: "unknown restart strategy"})
+	}
+
+	// the base solver reports a missing problem or generator
+	if problem := s.GetProblem(); problem != nil && problem.GetGenerator() != nil {
+		switch template, ok := problem.GenerateChromosome().(*real.Chromosome); {
+		case !ok:
+			errs = append(errs, &solver.ValidationError{Field: "problem", Value: problem.GetName(), Err: solver.ErrIncompatibleStrategy, Reason: "cma-es needs a problem of real valued chromosomes"})
+		case template.GetBounds() == nil:
+			errs = append(errs, &solver.ValidationError{Field: "bounds", Value: nil, Err: solver.ErrIncompatibleStrategy, Reason: "cma-es needs bounds to scale the initial step size"})
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// withoutDefaultSize removes the population size error of the base solver
+// when the size is zero, which asks for the default number of samples
+func (s *Solver) withoutDefaultSize(err error) error {
+
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok || s.GetPopulationSize() != 0 {
+		return err
+	}
+
+	var errs []error
+	for _, e := range joined.Unwrap() {
+		var invalid *solver.ValidationError
+		if errors.As(e, &invalid) && invalid.Field == "populationSize" {
+			continue
+		}
+		errs = append(errs, e)
+	}
+
+	return errors.Join(errs...)
+}
+
+// Initialise starts the search distribution and samples, evaluates and
+// learns from the first population
+func (s *Solver) Initialise() {
+	template := s.GetProblem().GenerateChromosome().(*real.Chromosome)
+	s.n = template.GetDimensions()
+	s.bounds = template.GetBounds()
+
+	mean := 0.0
+	for i := 0; i < s.n; i++ {
+		mean += s.bounds.GetRange(i)
+	}
+	s.sigma0 = s.sigmaFactor * mean / float64(s.n)
+
+	s.defaultLambda = s.GetPopulationSize()
+	if s.defaultLambda == 0 {
+		s.defaultLambda = 4 + int(3*math.Log(float64(s.n)))
+	}
+	s.largeLambda = s.defaultLambda
+	s.largeBudget, s.smallBudget = 0, 0
+	s.restarts = 0
+	s.regimeLarge = true
+	s.start(s.defaultLambda, s.sigma0)
+
+	s.Mutate()
+	s.Replace()
+}
+
+// Mutate samples and evaluates the offspring of the search distribution
+func (s *Solver) Mutate() {
+
+	offspring := make([]chromosome.IChromosome, s.lambda)
+
+	for k := range offspring {
+		rng := generator.Derive(s.GetProblem().GetGenerator(), streamSample, int64(s.GetGeneration()), int64(k))
+
+		// y = B D z for z drawn from the standard normal distribution
+		z := make([]float64, s.n)
+		for i := range z {
+			z[i] = s.d[i] * rng.NormFloat64()
+		}
+		x := make([]float64, s.n)
+		for i := range x {
+			y := 0.0
+			for j := range z {
+				y += s.b[i][j] * z[j]
+			}
+			x[i] = s.mean[i] + s.sigma*y
+		}
+		s.bounds.Repair(x, rng)
+
+		sample := s.GetProblem().GenerateChromosome().(*real.Chromosome)
+		sample.SetGenerator(rng)
+		sample.Phenotype = x
+		offspring[k] = sample
+	}
+
+	s.EvaluateChromosomes(&offspring)
+	s.SetOffspring(offspring)
+}
+
+// Crossover does nothing since the strategy recombines the offspring through
+// the weighted mean in the replacement stage
+func (s *Solver) Crossover() {}
+
+// Replace makes the ranked offspring the population, adapts the search
+// distribution to them and restarts or stops when it has converged
+func (s *Solver) Replace() {
+
+	offspring := s.GetOffspring()
+	s.SetOffspring(nil)
+
+	// keep the population of the last complete generation when cancelled
+	if s.GetContext().Err() != nil || len(offspring) == 0 {
+		return
+	}
+
+	s.SortChromosomes(&offspring)
+	s.SetPopulation(offspring)
+	s.update(offspring)
+
+	if reason := s.converged(offspring); reason != "" {
+		if s.restart == NoRestart || s.restarts >= s.maxRestarts {
+			s.Stop("cma-es converged: " + reason)
+			return
+		}
+		s.restartDistribution()
+	}
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// ADAPTATION /////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// start resets the search distribution to a random mean within the bounds
+// with the population size and step size
+func (s *Solver) start(lambda int, sigma float64) {
+
+	n := float64(s.n)
+	s.lambda = lambda
+	s.mu = lambda / 2
+	if s.mu < 1 {
+		s.mu = 1
+	}
+
+	// log-linear recombination weights normalised to sum to one
+	s.weights = make([]float64, s.mu)
+	total, squares := 0.0, 0.0
+	for i := range s.weights {
+		s.weights[i] = math.Log(float64(s.mu)+0.5) - math.Log(float64(i+1))
+		total += s.weights[i]
+	}
+	for i := range s.weights {
+		s.weights[i] /= total
+		squares += s.weights[i] * s.weights[i]
+	}
+	s.mueff = 1 / squares
+
+	s.cc = (4 + s.mueff/n) / (n + 4 + 2*s.mueff/n)
+	s.cs = (s.mueff + 2) / (n + s.mueff + 5)
+	s.c1 = 2 / ((n+1.3)*(n+1.3) + s.mueff)
+	s.cmu = math.Min(1-s.c1, 2*(s.mueff-2+1/s.mueff)/((n+2)*(n+2)+s.mueff))
+	s.damps = 1 + 2*math.Max(0, math.Sqrt((s.mueff-1)/(n+1))-1) + s.cs
+	s.chiN = math.Sqrt(n) * (1 - 1/(4*n) + 1/(21*n*n))
+
+	// a random mean within the bounds
+	rng := generator.Derive(s.GetProblem().GetGenerator(), streamRestart, int64(s.restarts))
+	s.mean = make([]float64, s.n)
+	for i := range s.mean {
+		s.mean[i] = s.bounds.Sample(i, rng)
+	}
+
+	s.sigma = sigma
+	s.pc = make([]float64, s.n)
+	s.ps = make([]float64, s.n)
+	s.c = identity(s.n)
+	s.b = identity(s.n)
+	s.invsqrtC = identity(s.n)
+	s.d = make([]float64, s.n)
+	for i := range s.d {
+		s.d[i] = 1
+	}
+	s.eigenAt = 0
+	s.g = 0
+	s.regimeStart = s.GetEvaluations()
+}
+
+// update adapts the mean, evolution paths, covariance matrix and step size to
+// the ranked samples
+func (s *Solver) update(ranked []chromosome.IChromosome) {
+
+	s.g++
+	n := s.n
+
+	// fewer samples than the parents remain when evaluations are discarded
+	weights := s.weights
+	if len(ranked) < len(weights) {
+		weights = make([]float64, len(ranked))
+		total := 0.0
+		for i := range weights {
+			weights[i] = s.weights[i]
+			total += weights[i]
+		}
+		for i := range weights {
+			weights[i] /= total
+		}
+	}
+
+	// the steps of the parents from the old mean in units of the step size
+	old := s.mean
+	steps := make([][]float64, len(weights))
+	s.mean = make([]float64, n)
+	for k := range weights {
+		x := ranked[k].(*real.Chromosome).Phenotype
+		steps[k] = make([]float64, n)
+		for i := 0; i < n; i++ {
+			steps[k][i] = (x[i] - old[i]) / s.sigma
+			s.mean[i] += weights[k] * x[i]
+		}
+	}
+
+	shift := make([]float64, n)
+	for i := range shift {
+		shift[i] = (s.mean[i] - old[i]) / s.sigma
+	}
+
+	// cumulate the conjugate evolution path for the step size
+	factor := math.Sqrt(s.cs * (2 - s.cs) * s.mueff)
+	for i := 0; i < n; i++ {
+		whitened := 0.0
+		for j := 0; j < n; j++ {
+			whitened += s.invsqrtC[i][j] * shift[j]
+		}
+		s.ps[i] = (1-s.cs)*s.ps[i] + factor*whitened
+	}
+
+	// stall the evolution path when the step size is growing quickly
+	hsig := 0.0
+	if norm(s.ps)/math.Sqrt(1-math.Pow(1-s.cs, 2*float64(s.g)))/s.chiN < 1.4+2/float64(n+1) {
+		hsig = 1
+	}
+
+	factor = math.Sqrt(s.cc * (2 - s.cc) * s.mueff)
+	for i := 0; i < n; i++ {
+		s.pc[i] = (1-s.cc)*s.pc[i] + hsig*factor*shift[i]
+	}
+
+	// the rank-one and rank-mu updates of the covariance matrix
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			rankOne := s.pc[i]*s.pc[j] + (1-hsig)*s.cc*(2-s.cc)*s.c[i][j]
+			rankMu := 0.0
+			for k, w := range weights {
+				rankMu += w * steps[k][i] * steps[k][j]
+			}
+			value := (1-s.c1-s.cmu)*s.c[i][j] + s.c1*rankOne + s.cmu*rankMu
+			s.c[i][j], s.c[j][i] = value, value
+		}
+	}
+
+	// cumulative step-size adaptation
+	s.sigma *= math.Exp((s.cs / s.damps) * (norm(s.ps)/s.chiN - 1))
+
+	// decompose the covariance matrix often enough to keep the cost of the
+	// decomposition below that of the updates
+	s.eigenAt += len(ranked)
+	if float64(s.eigenAt) > float64(s.lambda)/(s.c1+s.cmu)/float64(n)/10 {
+		s.eigenAt = 0
+		s.decompose()
+	}
+}
+
+// decompose updates the axes and scales of the distribution from the
+// covariance matrix
+func (s *Solver) decompose() {
+	values, vectors := eigen(s.c)
+	s.b = vectors
+	for i, value := range values {
+		s.d[i] = math.Sqrt(math.Max(value, 1e-20))
+	}
+	for i := 0; i < s.n; i++ {
+		for j := 0; j < s.n; j++ {
+			total := 0.0
+			for k := 0; k < s.n; k++ {
+				total += s.b[i][k] * s.b[j][k] / s.d[k]
+			}
+			s.invsqrtC[i][j] = total
+		}
+	}
+}
+
+// converged returns why the distribution can no longer make progress, or an
+// empty string when it can
+func (s *Solver) converged(ranked []chromosome.IChromosome) string {
+
+	first, last := ranked[0].GetFitness(), ranked[len(ranked)-1].GetFitness()
+	if math.IsNaN(s.sigma) || math.IsInf(s.sigma, 0) {
+		return "step size is not finite"
+	}
+
+	if math.Abs(first-last) < 1e-12 && s.g > 1 {
+		return "fitness is flat"
+	}
+
+	largest, smallest, negligible := 0.0, math.Inf(1), true
+	for i, d := range s.d {
+		largest = math.Max(largest, d)
+		smallest = math.Min(smallest, d)
+		if s.sigma*math.Max(math.Abs(s.pc[i]), math.Sqrt(s.c[i][i])) > 1e-12*s.sigma0 {
+			negligible = false
+		}
+	}
+
+	if negligible {
+		return "step size is negligible"
+	}
+
+	if largest/smallest > 1e7 {
+		return "covariance matrix is ill conditioned"
+	}
+
+	return ""
+}
+
+// restartDistribution starts the distribution again with the population and
+// step size chosen by the restart strategy
+func (s *Solver) restartDistribution() {
+
+	spent := s.GetEvaluations() - s.regimeStart
+	if s.regimeLarge {
+		s.largeBudget += spent
+	} else {
+		s.smallBudget += spent
+	}
+	s.restarts++
+
+	lambda, sigma := s.largeLambda, s.sigma0
+	s.regimeLarge = true
+
+	switch {
+	case s.restart == BIPOP && s.smallBudget < s.largeBudget:
+		u := s.GetGenerator().Float64()
+		lambda = int(float64(s.defaultLambda) * math.Pow(0.5*float64(s.largeLambda)/float64(s.defaultLambda), u*u))
+		if lambda < 2 {
+			lambda = 2
+		}
+		sigma = s.sigma0 * math.Pow(10, -2*s.GetGenerator().Float64())
+		s.regimeLarge = false
+	default:
+		s.largeLambda *= 2
+		lambda = s.largeLambda
+	}
+
+	s.start(lambda, sigma)
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// CONSTRUCTOR ////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// NewSolver creates a new CMA-ES Solver with the restart strategy. The
+// initial step size is 0.3 of the mean range of the bounds, the solver stops
+// after 9 restarts and a population size of zero uses the default for the
+// dimensions of the problem.
+func NewSolver(restart Restart) solver.ISolver {
+	s := &Solver{}
+	s.ISolver = s
+	s.SetRestart(restart)
+	s.SetSigma(0.3)
+	s.SetMaxRestarts(9)
+	s.SetEvaluationPolicy(solver.Abort)
+	return s
+}
@@ -0,0 +1,38 @@
+package de
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// ConstantControl uses the same scale factor and crossover rate for every
+// target, which is classic differential evolution
+type ConstantControl struct {
+	f  float64
+	cr float64
+}
+
+// GetF returns the scale factor
+func (c *ConstantControl) GetF() float64 {
+	return c.f
+}
+
+// GetCR returns the crossover rate
+func (c *ConstantControl) GetCR() float64 {
+	return c.cr
+}
+
+// Reset does nothing since the parameters never change
+func (c *ConstantControl) Reset() {}
+
+// Sample returns the scale factor and crossover rate
+func (c *ConstantControl) Sample(rng generator.IGenerator) (float64, float64) {
+	return c.f, c.cr
+}
+
+// Update does nothing since the parameters never change
+func (c *ConstantControl) Update(successes []Success) {}
+
+// NewConstantControl creates a new instance of the ConstantControl
+func NewConstantControl(f float64, cr float64) IParameterControl {
+	return &ConstantControl{f: f, cr: cr}
+}
@@ -0,0 +1,23 @@
+package de
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// Success records the parameters of a trial vector which replaced its target
+// together with the improvement in fitness it made.
+type Success struct {
+	F           float64
+	CR          float64
+	Improvement float64
+}
+
+// IParameterControl represents the interface for controlling the scale
+// factor F and the crossover rate CR of differential evolution. Parameters
+// are sampled for every target of a generation and the control is told which
+// of them succeeded at the end of the generation.
+type IParameterControl interface {
+	Reset()
+	Sample(rng generator.IGenerator) (f float64, cr float64)
+	Update(successes []Success)
+}
@@ -0,0 +1,55 @@
+package de
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// JADEControl adapts the parameters as in JADE (Zhang and Sanderson, 2009).
+// Crossover rates are drawn from a normal distribution and scale factors
+// from a Cauchy distribution, whose centres move towards the arithmetic and
+// Lehmer means of the successful parameters of each generation.
+type JADEControl struct {
+	c      float64
+	meanF  float64
+	meanCR float64
+}
+
+// GetMeanF returns the current location of the scale factors
+func (j *JADEControl) GetMeanF() float64 {
+	return j.meanF
+}
+
+// GetMeanCR returns the current mean of the crossover rates
+func (j *JADEControl) GetMeanCR() float64 {
+	return j.meanCR
+}
+
+// Reset returns the means to 0.5
+func (j *JADEControl) Reset() {
+	j.meanF = 0.5
+	j.meanCR = 0.5
+}
+
+// Sample draws the parameters for a target
+func (j *JADEControl) Sample(rng generator.IGenerator) (float64, float64) {
+	return sampleF(j.meanF, rng), sampleCR(j.meanCR, rng)
+}
+
+// Update moves the means towards the successful parameters at the learning
+// rate c
+func (j *JADEControl) Update(successes []Success) {
+	if len(successes) == 0 {
+		return
+	}
+	fs, crs, weights := split(successes, false)
+	j.meanCR = (1-j.c)*j.meanCR + j.c*weightedMean(crs, weights)
+	j.meanF = (1-j.c)*j.meanF + j.c*lehmerMean(fs, weights)
+}
+
+// NewJADEControl creates a new instance of the JADEControl with the learning
+// rate c, which is 0.1 in the original paper
+func NewJADEControl(c float64) IParameterControl {
+	j := &JADEControl{c: c}
+	j.Reset()
+	return j
+}
@@ -0,0 +1,71 @@
+package de
+
+import (
+	"math"
+
+	"github.com/opticverge/goevolution/generator"
+)
+
+// SHADEControl adapts the parameters as in SHADE (Tanabe and Fukunaga,
+// 2013). A history of means is kept and each target draws its parameters
+// around a random entry of the history. At the end of each generation the
+// next entry, in turn, is replaced by the means of the successful parameters
+// weighted by their improvement.
+type SHADEControl struct {
+	size     int
+	next     int
+	memoryF  []float64
+	memoryCR []float64
+}
+
+// GetSize returns the number of entries of the history
+func (s *SHADEControl) GetSize() int {
+	return s.size
+}
+
+// Reset fills the history with means of 0.5
+func (s *SHADEControl) Reset() {
+	s.next = 0
+	s.memoryF = make([]float64, s.size)
+	s.memoryCR = make([]float64, s.size)
+	for i := 0; i < s.size; i++ {
+		s.memoryF[i] = 0.5
+		s.memoryCR[i] = 0.5
+	}
+}
+
+// Sample draws the parameters for a target around a random entry
+func (s *SHADEControl) Sample(rng generator.IGenerator) (float64, float64) {
+	r := rng.Intn(s.size)
+	return sampleF(s.memoryF[r], rng), sampleCR(s.memoryCR[r], rng)
+}
+
+// Update replaces the next entry of the history. Successes without a finite
+// improvement, such as replacing a target whose evaluation failed, carry no
+// weight and are ignored.
+func (s *SHADEControl) Update(successes []Success) {
+	var weighted []Success
+	for _, success := range successes {
+		if success.Improvement > 0 && !math.IsInf(success.Improvement, 0) {
+			weighted = append(weighted, success)
+		}
+	}
+	if len(weighted) == 0 {
+		return
+	}
+	fs, crs, weights := split(weighted, true)
+	s.memoryCR[s.next] = weightedMean(crs, weights)
+	s.memoryF[s.next] = lehmerMean(fs, weights)
+	s.next = (s.next + 1) % s.size
+}
+
+// NewSHADEControl creates a new instance of the SHADEControl with a history
+// of the size, which is usually the population size
+func NewSHADEControl(size int) IParameterControl {
+	if size < 1 {
+		size = 1
+	}
+	s := &SHADEControl{size: size}
+	s.Reset()
+	return s
+}
@@ -0,0 +1,66 @@
+package de
+
+import (
+	"math"
+
+	"github.com/opticverge/goevolution/generator"
+)
+
+// sampleCR draws a crossover rate from the normal distribution around the
+// mean, truncated to [0, 1]
+func sampleCR(mean float64, rng generator.IGenerator) float64 {
+	return math.Min(1, math.Max(0, mean+0.1*rng.NormFloat64()))
+}
+
+// sampleF draws a scale factor from the Cauchy distribution around the
+// location, drawing again while it is not positive and truncating it to 1
+func sampleF(location float64, rng generator.IGenerator) float64 {
+	for {
+		f := location + 0.1*math.Tan(math.Pi*(rng.Float64()-0.5))
+		if f > 0 {
+			return math.Min(1, f)
+		}
+	}
+}
+
+// lehmerMean returns the weighted Lehmer mean of the values, which favours
+// larger values than the arithmetic mean
+func lehmerMean(values []float64, weights []float64) float64 {
+	numerator, denominator := 0.0, 0.0
+	for i, value := range values {
+		numerator += weights[i] * value * value
+		denominator += weights[i] * value
+	}
+	if denominator == 0 {
+		return 0
+	}
+	return numerator / denominator
+}
+
+// weightedMean returns the weighted arithmetic mean of the values
+func weightedMean(values []float64, weights []float64) float64 {
+	numerator, denominator := 0.0, 0.0
+	for i, value := range values {
+		numerator += weights[i] * value
+		denominator += weights[i]
+	}
+	if denominator == 0 {
+		return 0
+	}
+	return numerator / denominator
+}
+
+// split returns the scale factors and crossover rates of the successes with
+// equal weights, or weights proportional to the improvement when weighted
+func split(successes []Success, weighted bool) ([]float64, []float64, []float64) {
+	fs := make([]float64, len(successes))
+	crs := make([]float64, len(successes))
+	weights := make([]float64, len(successes))
+	for i, success := range successes {
+		fs[i], crs[i], weights[i] = success.F, success.CR, 1
+		if weighted {
+			weights[i] = success.Improvement
+		}
+	}
+	return fs, crs, weights
+}
@@ -0,0 +1,354 @@
+package de
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/chromosome/real"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/solver"
+)
+
+// streamTrial separates the seeds of the trial vectors from the streams of
+// the base solver
+const streamTrial int64 = 101
+
+// Solver implements differential evolution over real valued chromosomes. In
+// every generation each chromosome of the population is the target of a
+// mutant vector built by the strategy, which is recombined with the target
+// by binomial crossover into a trial. The trial replaces its target when it
+// is at least as good.
+type Solver struct {
+	solver.Solver
+	strategy Strategy
+	control  IParameterControl
+	p        float64
+
+	archive    []chromosome.IChromosome
+	mutants    [][]float64
+	parameters []Success
+	trials     []chromosome.IChromosome
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// SETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// SetStrategy sets how the mutant vectors are built
+func (d *Solver) SetStrategy(strategy Strategy) {
+	d.strategy = strategy
+}
+
+// SetParameterControl sets how the scale factor and crossover rate are
+// chosen
+func (d *Solver) SetParameterControl(control IParameterControl) {
+	d.control = control
+}
+
+// SetP sets the proportion of the best of the population the current to
+// pbest strategy moves towards
+func (d *Solver) SetP(p float64) {
+	d.p = p
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// GETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// GetStrategy returns how the mutant vectors are built
+func (d *Solver) GetStrategy() Strategy {
+	return d.strategy
+}
+
+// GetParameterControl returns how the scale factor and crossover rate are
+// chosen
+func (d *Solver) GetParameterControl() IParameterControl {
+	return d.control
+}
+
+// GetP returns the proportion of the best of the population the current to
+// pbest strategy moves towards
+func (d *Solver) GetP() float64 {
+	return d.p
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// ISOLVER IMPLEMENTATIONS ////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// Validate checks the configuration of the base solver, that the population
+// is large enough for the strategy and that the problem generates real
+// valued chromosomes
+func (d *Solver) Validate() error {
+
+	errs := []error{d.Solver.Validate()}
+
+	switch d.strategy {
+	case Rand1Bin, Best1Bin, CurrentToBest1Bin, Rand2Bin, CurrentToPBest1Bin:
+		if minimum := d.strategy.vectors() + 1; d.GetPopulationSize() < minimum {
+			errs = append(errs, &solver.ValidationError{Field: "populationSize", Value: d.GetPopulationSize(), Err: solver.ErrInvalidPopulationSize, Reason: fmt.Sprintf("%v needs at least %v chromosomes", d.strategy, minimum)})
+		}
+	default:
+		errs = append(errs, &solver.ValidationError{Field: "strategy", Value: d.strategy, Err: solver.ErrIncompatibleStrategy, Reason: "unknown differential evolution strategy"})
+	}
+
+	if d.control == nil {
+		errs = append(errs, &solver.ValidationError{Field: "control", Value: nil, Err: solver.ErrIncompatibleStrategy, Reason: "call SetParameterControl before running the solver"})
+	}
+
+	if d.p <= 0 || d.p > 1 {
+		errs = append(errs, &solver.ValidationError{Field: "p", Value: d.p, Err: solver.ErrInvalidRate, Reason: "the proportion must be greater than 0 and at most 1"})
+	}
+
+	// the base solver reports a missing problem or generator
+	if problem := d.GetProblem(); problem != nil && problem.GetGenerator() != nil {
+		if _, ok := problem.GenerateChromosome().(*real.Chromosome); !ok {
+			errs = append(errs, &solver.ValidationError{Field: "problem", Value: problem.GetName(), Err: solver.ErrIncompatibleStrategy, Reason: "differential evolution needs a problem of real valued chromosomes"})
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Terminated ends the run as the base solver does, or when discarded
+// evaluations have left fewer chromosomes than the strategy needs to build
+// its mutants
+func (d *Solver) Terminated() bool {
+	if minimum, size := d.strategy.vectors()+1, len(d.GetPopulation()); size < minimum {
+		d.Stop(fmt.Sprintf("%v needs at least %v chromosomes but %v remain", d.strategy, minimum, size))
+	}
+	return d.Solver.Terminated()
+}
+
+// Setup prepares the base solver and resets the parameter control and the
+// archive
+func (d *Solver) Setup() {
+	d.Solver.Setup()
+	d.control.Reset()
+	d.archive = nil
+}
+
+// Mutate builds a mutant vector for every target of the population
+func (d *Solver) Mutate() {
+
+	population := d.GetPopulation()
+	rng := d.GetGenerator()
+	obj := d.GetProblem().GetObjective()
+
+	// rank the population for the best and pbest strategies
+	ranked := make([]int, len(population))
+	for i := range ranked {
+		ranked[i] = i
+	}
+	sort.SliceStable(ranked, func(a, b int) bool {
+		return obj.IsBetter(population[ranked[a]].GetFitness(), population[ranked[b]].GetFitness())
+	})
+	top := int(math.Max(1, math.Round(d.p*float64(len(population)))))
+
+	d.mutants = make([][]float64, len(population))
+	d.parameters = make([]Success, len(population))
+
+	for i := range population {
+		f, cr := d.control.Sample(rng)
+		d.parameters[i] = Success{F: f, CR: cr}
+
+		x := phenotype(population[i])
+		r := distinct(rng, len(population), d.strategy.vectors(), i)
+		v := make([]float64, len(x))
+
+		switch d.strategy {
+		case Rand1Bin:
+			a, b, c := phenotype(population[r[0]]), phenotype(population[r[1]]), phenotype(population[r[2]])
+			for j := range v {
+				v[j] = a[j] + f*(b[j]-c[j])
+			}
+		case Best1Bin:
+			best, b, c := phenotype(population[ranked[0]]), phenotype(population[r[0]]), phenotype(population[r[1]])
+			for j := range v {
+				v[j] = best[j] + f*(b[j]-c[j])
+			}
+		case CurrentToBest1Bin:
+			best, b, c := phenotype(population[ranked[0]]), phenotype(population[r[0]]), phenotype(population[r[1]])
+			for j := range v {
+				v[j] = x[j] + f*(best[j]-x[j]) + f*(b[j]-c[j])
+			}
+		case Rand2Bin:
+			a, b, c := phenotype(population[r[0]]), phenotype(population[r[1]]), phenotype(population[r[2]])
+			e, g := phenotype(population[r[3]]), phenotype(population[r[4]])
+			for j := range v {
+				v[j] = a[j] + f*(b[j]-c[j]) + f*(e[j]-g[j])
+			}
+		case CurrentToPBest1Bin:
+			pbest := phenotype(population[ranked[rng.Intn(top)]])
+			b := phenotype(population[r[0]])
+
+			// the second vector comes from the population and the archive
+			k := rng.Intn(len(population) + len(d.archive))
+			for k == i || k == r[0] {
+				k = rng.Intn(len(population) + len(d.archive))
+			}
+			var c []float64
+			if k < len(population) {
+				c = phenotype(population[k])
+			} else {
+				c = phenotype(d.archive[k-len(population)])
+			}
+
+			for j := range v {
+				v[j] = x[j] + f*(pbest[j]-x[j]) + f*(b[j]-c[j])
+			}
+		}
+
+		d.mutants[i] = v
+	}
+}
+
+// Crossover recombines every target with its mutant by binomial crossover
+// and evaluates the trials, which become the offspring of the generation
+func (d *Solver) Crossover() {
+
+	population := d.GetPopulation()
+	rng := d.GetGenerator()
+
+	trials := make([]chromosome.IChromosome, len(population))
+	position := make(map[chromosome.IChromosome]int, len(population))
+
+	for i, target := range population {
+		x := phenotype(target)
+		cr := d.parameters[i].CR
+
+		// at least one gene always comes from the mutant
+		u := make([]float64, len(x))
+		forced := rng.Intn(len(x))
+		for j := range u {
+			if j == forced || rng.Float64() < cr {
+				u[j] = d.mutants[i][j]
+			} else {
+				u[j] = x[j]
+			}
+		}
+
+		trial := target.Clone(generator.Derive(d.GetProblem().GetGenerator(), streamTrial, int64(d.GetGeneration()), int64(i))).(*real.Chromosome)
+		trial.GetBounds().Repair(u, trial.GetGenerator())
+		trial.Phenotype = u
+
+		trials[i] = trial
+		position[trial] = i
+	}
+
+	// discarded trials are missing from the evaluated slice so each is
+	// matched back to its target
+	evaluated := append([]chromosome.IChromosome(nil), trials...)
+	d.EvaluateChromosomes(&evaluated)
+
+	d.trials = make([]chromosome.IChromosome, len(population))
+	for _, trial := range evaluated {
+		d.trials[position[trial]] = trial
+	}
+
+	d.SetOffspring(evaluated)
+}
+
+// Replace keeps each trial which is at least as good as its target, adding
+// replaced targets to the archive and reporting the parameters of the trials
+// which improved on their targets to the parameter control
+func (d *Solver) Replace() {
+
+	defer d.SetOffspring(nil)
+
+	// keep the population of the last complete generation when cancelled
+	if d.GetContext().Err() != nil {
+		return
+	}
+
+	population := d.GetPopulation()
+	obj := d.GetProblem().GetObjective()
+	rng := d.GetGenerator()
+
+	next := make([]chromosome.IChromosome, len(population))
+	var successes []Success
+
+	for i, target := range population {
+		next[i] = target
+
+		trial := d.trials[i]
+		if trial == nil || obj.IsBetter(target.GetFitness(), trial.GetFitness()) {
+			continue
+		}
+
+		next[i] = trial
+
+		if obj.IsBetter(trial.GetFitness(), target.GetFitness()) {
+			success := d.parameters[i]
+			success.Improvement = math.Abs(target.GetFitness() - trial.GetFitness())
+			successes = append(successes, success)
+
+			d.archive = append(d.archive, target)
+		}
+	}
+
+	// the archive is kept to the size of the population by removing random
+	// chromosomes
+	for len(d.archive) > len(population) {
+		k := rng.Intn(len(d.archive))
+		d.archive[k] = d.archive[len(d.archive)-1]
+		d.archive = d.archive[:len(d.archive)-1]
+	}
+
+	d.control.Update(successes)
+	d.SetPopulation(next)
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// HELPERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// phenotype returns the vector of a real valued chromosome
+func phenotype(chromo chromosome.IChromosome) []float64 {
+	return chromo.(*real.Chromosome).Phenotype
+}
+
+// distinct returns count distinct random indices below n which differ from
+// the excluded index. Terminated ends the run before n is too small.
+func distinct(rng generator.IGenerator, n int, count int, exclude int) []int {
+	indices := make([]int, 0, count)
+	for len(indices) < count {
+		k := rng.Intn(n)
+		if k == exclude || contains(indices, k) {
+			continue
+		}
+		indices = append(indices, k)
+	}
+	return indices
+}
+
+// contains returns true when the indices hold the index
+func contains(indices []int, index int) bool {
+	for _, i := range indices {
+		if i == index {
+			return true
+		}
+	}
+	return false
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// CONSTRUCTOR ////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// NewSolver creates a new differential evolution Solver with the strategy and
+// parameter control, such as NewConstantControl(0.5, 0.9) for classic DE or
+// NewJADEControl(0.1) and NewSHADEControl(populationSize) for adaptive DE.
+// The current to pbest strategy moves towards the best 10% by default.
+func NewSolver(strategy Strategy, control IParameterControl) solver.ISolver {
+	d := &Solver{}
+	d.ISolver = d
+	d.SetStrategy(strategy)
+	d.SetParameterControl(control)
+	d.SetP(0.1)
+	d.SetEvaluationPolicy(solver.Abort)
+	return d
+}
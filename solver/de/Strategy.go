@@ -0,0 +1,40 @@
+package de
+
+// Strategy is a type which defines how the mutant vector of each target is
+// built from the population. Every strategy is followed by binomial
+// crossover with the target.
+type Strategy string
+
+const (
+	// Rand1Bin adds the scaled difference of two random vectors to a third
+	Rand1Bin Strategy = "rand/1/bin"
+
+	// Best1Bin adds the scaled difference of two random vectors to the best
+	Best1Bin Strategy = "best/1/bin"
+
+	// CurrentToBest1Bin moves the target towards the best and adds the
+	// scaled difference of two random vectors
+	CurrentToBest1Bin Strategy = "current-to-best/1/bin"
+
+	// Rand2Bin adds the scaled differences of two pairs of random vectors to
+	// a fifth
+	Rand2Bin Strategy = "rand/2/bin"
+
+	// CurrentToPBest1Bin moves the target towards one of the best p of the
+	// population and draws the second vector of the difference from the
+	// population and the archive of replaced targets, as in JADE and SHADE
+	CurrentToPBest1Bin Strategy = "current-to-pbest/1/bin"
+)
+
+// vectors returns the number of random vectors the strategy draws besides
+// the target, which bounds the smallest population it can work with
+func (s Strategy) vectors() int {
+	switch s {
+	case Rand1Bin:
+		return 3
+	case Rand2Bin:
+		return 5
+	default:
+		return 2
+	}
+}
@@ -0,0 +1,256 @@
+package nsga2
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/objective"
+	"github.com/opticverge/goevolution/solver"
+)
+
+// streams separate the seeds of the crossovers and the children from the
+// streams of the base solver
+const (
+	streamChild     int64 = 501
+	streamCrossover int64 = 502
+)
+
+// Solver implements the non-dominated sorting genetic algorithm II of Deb et
+// al. (2002) for problems with several objectives. Parents are chosen by
+// binary tournaments on their front and crowding distance, recombined with
+// the crossover rate of the solver and mutated. The population and its
+// offspring are then sorted into Pareto fronts and the next population is
+// filled front by front, breaking ties in the last front by crowding
+// distance so that the front stays spread out.
+type Solver struct {
+	solver.Solver
+	probability float64
+
+	ranks    map[chromosome.IChromosome]int
+	crowding map[chromosome.IChromosome]float64
+	parents  []chromosome.IChromosome
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// SETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// SetMutationProbability sets the probability the children are mutated with,
+// where zero uses one over the dimensions of the problem
+func (s *Solver) SetMutationProbability(probability float64) {
+	s.probability = probability
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// GETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// GetMutationProbability returns the probability the children are mutated
+// with
+func (s *Solver) GetMutationProbability() float64 {
+	return s.probability
+}
+
+// GetFront returns the chromosomes of the population which no other
+// chromosome of the population dominates
+func (s *Solver) GetFront() []chromosome.IChromosome {
+	var front []chromosome.IChromosome
+	for _, chromo := range s.GetPopulation() {
+		if s.ranks[chromo] == 0 {
+			front = append(front, chromo)
+		}
+	}
+	return front
+}
+
+// GetRank returns the front of the chromosome of the population, where 0 is
+// the Pareto front
+func (s *Solver) GetRank(chromo chromosome.IChromosome) int {
+	return s.ranks[chromo]
+}
+
+// GetCrowdingDistance returns the crowding distance of the chromosome of the
+// population within its front
+func (s *Solver) GetCrowdingDistance(chromo chromosome.IChromosome) float64 {
+	return s.crowding[chromo]
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// ISOLVER IMPLEMENTATIONS ////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// Validate checks the configuration of the base solver, the mutation
+// probability and the directions of the objectives
+func (s *Solver) Validate() error {
+
+	errs := []error{s.Solver.Validate()}
+
+	// the base solver reports a population smaller than 1
+	if s.GetPopulationSize() == 1 {
+		errs = append(errs, &solver.ValidationError{Field: "populationSize", Value: s.GetPopulationSize(), Err: solver.ErrInvalidPopulationSize, Reason: "tournaments need at least 2 chromosomes"})
+	}
+
+	if s.probability < 0 || s.probability > 1 {
+		errs = append(errs, &solver.ValidationError{Field: "probability", Value: s.probability, Err: solver.ErrInvalidRate, Reason: "the probability must be between 0 and 1"})
+	}
+
+	// the base solver reports a missing problem
+	if problem := s.GetProblem(); problem != nil {
+		for _, o := range problem.GetObjectives() {
+			if o != objective.Maximisation && o != objective.Minimisation {
+				errs = append(errs, &solver.ValidationError{Field: "objectives", Value: problem.GetObjectives(), Err: solver.ErrIncompatibleStrategy, Reason: "every objective must be a maximisation or a minimisation"})
+				break
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Initialise generates and evaluates the population and sorts it into fronts
+func (s *Solver) Initialise() {
+	s.Solver.Initialise()
+	s.SetPopulation(s.survivors(s.GetPopulation(), len(s.GetPopulation())))
+}
+
+// Mutate chooses the parents of the offspring by binary tournaments, where
+// the chromosome on the better front wins and ties are won by the larger
+// crowding distance
+func (s *Solver) Mutate() {
+
+	population := s.GetPopulation()
+	rng := s.GetGenerator()
+
+	s.parents = make([]chromosome.IChromosome, len(population))
+	for i := range s.parents {
+		a, b := population[rng.Intn(len(population))], population[rng.Intn(len(population))]
+		if s.crowdedBetter(b, a) {
+			a = b
+		}
+		s.parents[i] = a
+	}
+}
+
+// Crossover recombines pairs of parents with the crossover rate of the
+// solver, or clones them when they are not recombined, then mutates and
+// evaluates the children, which become the offspring of the generation
+func (s *Solver) Crossover() {
+
+	if s.GetContext().Err() != nil {
+		return
+	}
+
+	rng := s.GetGenerator()
+	var children []chromosome.IChromosome
+
+	for i := 0; i < len(s.parents); i += 2 {
+		first, second := s.parents[i], s.parents[(i+1)%len(s.parents)]
+
+		// chromosomes without a crossover, or whose crossover returns fewer
+		// than two children, pass clones of the parents on instead
+		if crossover, ok := first.(chromosome.ICrossover); ok && rng.Float64() < s.GetCrossoverRate() {
+			if offspring := crossover.Crossover(second, generator.Derive(s.GetProblem().GetGenerator(), streamCrossover, int64(s.GetGeneration()), int64(i))); len(offspring) >= 2 {
+				children = append(children, offspring[0], offspring[1])
+				continue
+			}
+		}
+		children = append(children, first.Clone(rng), second.Clone(rng))
+	}
+
+	children = children[:len(s.parents)]
+
+	probability := s.probability
+	if probability == 0 {
+		probability = 1 / float64(s.GetProblem().GetDimensions())
+	}
+
+	// every child has its own generator so that they mutate in parallel
+	s.GetPool().Run(len(children), func(pos int) {
+		children[pos].SetGenerator(generator.Derive(s.GetProblem().GetGenerator(), streamChild, int64(s.GetGeneration()), int64(pos)))
+		children[pos].Mutate(probability)
+	})
+
+	s.EvaluateChromosomes(&children)
+	s.SetOffspring(children)
+}
+
+// Replace sorts the population and the offspring into fronts and keeps the
+// best fronts, breaking ties in the last of them by crowding distance
+func (s *Solver) Replace() {
+
+	defer s.SetOffspring(nil)
+
+	// keep the population of the last complete generation when cancelled
+	if s.GetContext().Err() != nil {
+		return
+	}
+
+	combined := append(append([]chromosome.IChromosome(nil), s.GetPopulation()...), s.GetOffspring()...)
+	s.SetPopulation(s.survivors(combined, s.GetPopulationSize()))
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// HELPERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// survivors sorts the chromosomes into fronts, records the front and crowding
+// distance of every chromosome and returns the best count of them
+func (s *Solver) survivors(chromosomes []chromosome.IChromosome, count int) []chromosome.IChromosome {
+
+	s.ranks = make(map[chromosome.IChromosome]int, count)
+	s.crowding = make(map[chromosome.IChromosome]float64, count)
+
+	var survivors []chromosome.IChromosome
+
+	for rank, front := range NonDominatedSort(chromosomes, s.GetProblem().GetObjectives()) {
+		if len(survivors) >= count {
+			break
+		}
+
+		distances := CrowdingDistances(front)
+		for i, chromo := range front {
+			s.ranks[chromo] = rank
+			s.crowding[chromo] = distances[i]
+		}
+
+		// the last front to fit keeps its least crowded chromosomes
+		if len(survivors)+len(front) > count {
+			front = append([]chromosome.IChromosome(nil), front...)
+			sort.SliceStable(front, func(a, b int) bool {
+				return s.crowding[front[a]] > s.crowding[front[b]]
+			})
+			front = front[:count-len(survivors)]
+		}
+
+		survivors = append(survivors, front...)
+	}
+
+	return survivors
+}
+
+// crowdedBetter reports whether a is on a better front than b, or on the same
+// front and less crowded
+func (s *Solver) crowdedBetter(a chromosome.IChromosome, b chromosome.IChromosome) bool {
+	if s.ranks[a] != s.ranks[b] {
+		return s.ranks[a] < s.ranks[b]
+	}
+	return s.crowding[a] > s.crowding[b]
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// CONSTRUCTOR ////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// NewSolver creates a new NSGA-II Solver for a problem whose objectives are
+// set with SetObjectives. Pairs of parents are recombined with a crossover
+// rate of 0.9 and the children are mutated with a probability of one over the
+// dimensions of the problem.
+func NewSolver() solver.ISolver {
+	s := &Solver{}
+	s.ISolver = s
+	s.SetCrossoverRate(0.9)
+	s.SetEvaluationPolicy(solver.Abort)
+	return s
+}
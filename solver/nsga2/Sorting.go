@@ -0,0 +1,103 @@
+package nsga2
+
+import (
+	"math"
+	"sort"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/objective"
+)
+
+// NonDominatedSort splits the population into Pareto fronts by the fast
+// non-dominated sort of Deb et al. (2002). The first front holds the
+// chromosomes which no other chromosome dominates, the second those only
+// dominated by the first front and so on.
+func NonDominatedSort(population []chromosome.IChromosome, objectives []objective.Objective) [][]chromosome.IChromosome {
+
+	// the chromosomes each chromosome dominates and the number of
+	// chromosomes which dominate it
+	dominated := make([][]int, len(population))
+	counts := make([]int, len(population))
+
+	var current []int
+
+	for i := range population {
+		a := population[i].GetFitnesses()
+		for j := i + 1; j < len(population); j++ {
+			b := population[j].GetFitnesses()
+			if objective.Dominates(a, b, objectives) {
+				dominated[i] = append(dominated[i], j)
+				counts[j]++
+			} else if objective.Dominates(b, a, objectives) {
+				dominated[j] = append(dominated[j], i)
+				counts[i]++
+			}
+		}
+	}
+
+	for i, count := range counts {
+		if count == 0 {
+			current = append(current, i)
+		}
+	}
+
+	var fronts [][]chromosome.IChromosome
+
+	for len(current) > 0 {
+		front := make([]chromosome.IChromosome, len(current))
+		var next []int
+		for k, i := range current {
+			front[k] = population[i]
+			for _, j := range dominated[i] {
+				counts[j]--
+				if counts[j] == 0 {
+					next = append(next, j)
+				}
+			}
+		}
+		fronts = append(fronts, front)
+		current = next
+	}
+
+	return fronts
+}
+
+// CrowdingDistances returns the crowding distance of every chromosome of the
+// front, which is the sum over the objectives of the normalised distance
+// between its neighbours. The chromosomes at the extremes of any objective
+// have an infinite distance so that they are always kept.
+func CrowdingDistances(front []chromosome.IChromosome) []float64 {
+
+	distances := make([]float64, len(front))
+	if len(front) == 0 {
+		return distances
+	}
+
+	order := make([]int, len(front))
+	for m := range front[0].GetFitnesses() {
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(a, b int) bool {
+			return front[order[a]].GetFitnesses()[m] < front[order[b]].GetFitnesses()[m]
+		})
+
+		first, last := order[0], order[len(order)-1]
+		distances[first] = math.Inf(1)
+		distances[last] = math.Inf(1)
+
+		// fronts holding the infinite fitnesses of failed evaluations have no
+		// finite span to normalise by
+		span := front[last].GetFitnesses()[m] - front[first].GetFitnesses()[m]
+		if span == 0 || math.IsInf(span, 0) || math.IsNaN(span) {
+			continue
+		}
+
+		for k := 1; k < len(order)-1; k++ {
+			gap := front[order[k+1]].GetFitnesses()[m] - front[order[k-1]].GetFitnesses()[m]
+			distances[order[k]] += gap / span
+		}
+	}
+
+	return distances
+}
@@ -0,0 +1,12 @@
+package pso
+
+import (
+	"github.com/opticverge/goevolution/generator"
+)
+
+// IInertia is the interface for the schedules which weight how much of its
+// velocity a particle keeps from one generation to the next. Large weights
+// favour exploration and small weights favour exploitation.
+type IInertia interface {
+	Weight(generation int, epochs int, rng generator.IGenerator) float64
+}
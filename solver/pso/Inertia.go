@@ -0,0 +1,59 @@
+package pso
+
+import (
+	"math"
+
+	"github.com/opticverge/goevolution/generator"
+)
+
+// ConstantInertia uses the same inertia weight in every generation
+type ConstantInertia struct {
+	weight float64
+}
+
+// Weight returns the inertia weight
+func (c *ConstantInertia) Weight(generation int, epochs int, rng generator.IGenerator) float64 {
+	return c.weight
+}
+
+// NewConstantInertia creates a new instance of the ConstantInertia
+func NewConstantInertia(weight float64) IInertia {
+	return &ConstantInertia{weight: weight}
+}
+
+// LinearInertia decreases the inertia weight linearly from a start value to
+// an end value over the epochs of the solver (Shi and Eberhart, 1998)
+type LinearInertia struct {
+	start float64
+	end   float64
+}
+
+// Weight returns the inertia weight for the generation, which stays at the
+// start value when the run is unbounded
+func (l *LinearInertia) Weight(generation int, epochs int, rng generator.IGenerator) float64 {
+	if epochs <= 0 {
+		return l.start
+	}
+	progress := math.Min(float64(generation)/float64(epochs), 1.0)
+	return l.start + (l.end-l.start)*progress
+}
+
+// NewLinearInertia creates a new instance of the LinearInertia
+func NewLinearInertia(start float64, end float64) IInertia {
+	return &LinearInertia{start: start, end: end}
+}
+
+// RandomInertia draws the inertia weight uniformly from [0.5, 1) for every
+// particle, which suits tracking problems whose optimum moves (Eberhart and
+// Shi, 2001)
+type RandomInertia struct{}
+
+// Weight returns a random inertia weight
+func (r *RandomInertia) Weight(generation int, epochs int, rng generator.IGenerator) float64 {
+	return 0.5 + rng.Float64()/2
+}
+
+// NewRandomInertia creates a new instance of the RandomInertia
+func NewRandomInertia() IInertia {
+	return &RandomInertia{}
+}
@@ -0,0 +1,339 @@
+package pso
+
+import (
+	"errors"
+	"math"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/chromosome/real"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/solver"
+)
+
+// Streams separate the seeds of the particles from the streams of the base
+// solver
+const (
+	streamVelocity int64 = iota + 301
+	streamMove
+)
+
+// Solver implements particle swarm optimisation over real valued
+// chromosomes. The population is the swarm, where every particle keeps a
+// velocity and the best position it has found. In every generation each
+// particle accelerates towards its own best position and the best position
+// found by the particles that inform it under the topology, then moves and
+// is evaluated.
+type Solver struct {
+	solver.Solver
+	topology     Topology
+	inertia      IInertia
+	cognitive    float64
+	social       float64
+	constriction bool
+	clamp        float64
+
+	particles   []*real.Chromosome
+	velocities  [][]float64
+	bests       [][]float64
+	bestFitness []float64
+	moved       []*real.Chromosome
+	steps       [][]float64
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// SETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// SetTopology sets which particles inform each other of their best positions
+func (s *Solver) SetTopology(topology Topology) {
+	s.topology = topology
+}
+
+// SetInertia sets the schedule of the inertia weight
+func (s *Solver) SetInertia(inertia IInertia) {
+	s.inertia = inertia
+}
+
+// SetAcceleration sets the cognitive coefficient which pulls a particle
+// towards its own best position and the social coefficient which pulls it
+// towards the best position of its neighbourhood
+func (s *Solver) SetAcceleration(cognitive float64, social float64) {
+	s.cognitive = cognitive
+	s.social = social
+}
+
+// SetConstriction sets whether the velocity is scaled by the constriction
+// factor of Clerc and Kennedy instead of the inertia weight. The sum of the
+// acceleration coefficients must then exceed 4.
+func (s *Solver) SetConstriction(constriction bool) {
+	s.constriction = constriction
+}
+
+// SetVelocityClamp sets the largest speed of a particle in every dimension as
+// a proportion of the range of the bounds, where zero leaves the velocity
+// unclamped
+func (s *Solver) SetVelocityClamp(clamp float64) {
+	s.clamp = clamp
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// GETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// GetTopology returns which particles inform each other of their best
+// positions
+func (s *Solver) GetTopology() Topology {
+	return s.topology
+}
+
+// GetInertia returns the schedule of the inertia weight
+func (s *Solver) GetInertia() IInertia {
+	return s.inertia
+}
+
+// GetAcceleration returns the cognitive and social coefficients
+func (s *Solver) GetAcceleration() (float64, float64) {
+	return s.cognitive, s.social
+}
+
+// GetConstriction returns whether the velocity is scaled by the constriction
+// factor
+func (s *Solver) GetConstriction() bool {
+	return s.constriction
+}
+
+// GetVelocityClamp returns the largest speed of a particle as a proportion of
+// the range of the bounds
+func (s *Solver) GetVelocityClamp() float64 {
+	return s.clamp
+}
+
+// GetVelocities returns the velocity of every particle of the swarm
+func (s *Solver) GetVelocities() [][]float64 {
+	return s.velocities
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// ISOLVER IMPLEMENTATIONS ////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// Validate checks the configuration of the base solver, the parameters of
+// the swarm and that the problem generates real valued chromosomes
+func (s *Solver) Validate() error {
+
+	errs := []error{s.Solver.Validate()}
+
+	// the base solver reports a swarm smaller than 1
+	if s.GetPopulationSize() == 1 {
+		errs = append(errs, &solver.ValidationError{Field: "populationSize", Value: s.GetPopulationSize(), Err: solver.ErrInvalidPopulationSize, Reason: "the swarm needs at least 2 particles"})
+	}
+
+	switch s.topology {
+	case GlobalBest, Ring, VonNeumann:
+	default:
+		errs = append(errs, &solver.ValidationError{Field: "topology", Value: s.topology, Err: solver.ErrIncompatibleStrategy, Reason: "unknown topology"})
+	}
+
+	if s.cognitive < 0 || s.social < 0 {
+		errs = append(errs, &solver.ValidationError{Field: "acceleration", Value: []float64{s.cognitive, s.social}, Err: solver.ErrIncompatibleStrategy, Reason: "the acceleration coefficients cannot be negative"})
+	}
+
+	if s.constriction && s.cognitive+s.social <= 4 {
+		errs = append(errs, &solver.ValidationError{Field: "acceleration", Value: s.cognitive + s.social, Err: solver.ErrIncompatibleStrategy, Reason: "the constriction factor needs coefficients which sum to more than 4"})
+	}
+
+	if !s.constriction && s.inertia == nil {
+		errs = append(errs, &solver.ValidationError{Field: "inertia", Value: nil, Err: solver.ErrIncompatibleStrategy, Reason: "call SetInertia or SetConstriction before running the solver"})
+	}
+
+	if s.clamp < 0 {
+		errs = append(errs, &solver.ValidationError{Field: "clamp", Value: s.clamp, Err: solver.ErrInvalidRate, Reason: "the velocity clamp cannot be negative"})
+	}
+
+	// the base solver reports a missing problem or generator
+	if problem := s.GetProblem(); problem != nil && problem.GetGenerator() != nil {
+		if _, ok := problem.GenerateChromosome().(*real.Chromosome); !ok {
+			errs = append(errs, &solver.ValidationError{Field: "problem", Value: problem.GetName(), Err: solver.ErrIncompatibleStrategy, Reason: "particle swarm optimisation needs a problem of real valued chromosomes"})
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Initialise generates and evaluates the swarm, giving every particle a
+// random velocity towards a random point within the bounds
+func (s *Solver) Initialise() {
+
+	swarm := s.GenerateChromosomes(s.GetPopulationSize())
+	s.EvaluateChromosomes(&swarm)
+
+	s.particles = make([]*real.Chromosome, len(swarm))
+	s.velocities = make([][]float64, len(swarm))
+	s.bests = make([][]float64, len(swarm))
+	s.bestFitness = make([]float64, len(swarm))
+
+	for i, chromo := range swarm {
+		particle := chromo.(*real.Chromosome)
+		bounds := particle.GetBounds()
+		rng := generator.Derive(s.GetProblem().GetGenerator(), streamVelocity, int64(i))
+
+		velocity := make([]float64, len(particle.Phenotype))
+		for j, x := range particle.Phenotype {
+			velocity[j] = (bounds.Sample(j, rng) - x) / 2
+		}
+
+		s.particles[i] = particle
+		s.velocities[i] = velocity
+		s.bests[i] = append([]float64(nil), particle.Phenotype...)
+		s.bestFitness[i] = particle.GetFitness()
+	}
+
+	s.SetPopulation(s.swarm())
+}
+
+// Mutate accelerates every particle towards its own best position and the
+// best position of its neighbourhood, then moves and evaluates the particles
+func (s *Solver) Mutate() {
+
+	obj := s.GetProblem().GetObjective()
+	size := len(s.particles)
+	phi := s.cognitive + s.social
+	chi := 2 / math.Abs(2-phi-math.Sqrt(phi*phi-4*phi))
+
+	s.steps = make([][]float64, size)
+	moved := make([]chromosome.IChromosome, size)
+	position := make(map[chromosome.IChromosome]int, size)
+
+	for i, particle := range s.particles {
+		rng := generator.Derive(s.GetProblem().GetGenerator(), streamMove, int64(s.GetGeneration()), int64(i))
+		bounds := particle.GetBounds()
+
+		// the best position known to the neighbourhood of the particle
+		informant := i
+		for _, k := range s.topology.Neighbours(i, size) {
+			if obj.IsBetter(s.bestFitness[k], s.bestFitness[informant]) {
+				informant = k
+			}
+		}
+
+		w := 1.0
+		if !s.constriction {
+			w = s.inertia.Weight(s.GetGeneration(), s.GetEpochs(), rng)
+		}
+
+		x := particle.Phenotype
+		next := make([]float64, len(x))
+		velocity := make([]float64, len(x))
+
+		for j := range x {
+			v := w*s.velocities[i][j] +
+				s.cognitive*rng.Float64()*(s.bests[i][j]-x[j]) +
+				s.social*rng.Float64()*(s.bests[informant][j]-x[j])
+
+			if s.constriction {
+				v *= chi
+			}
+
+			if limit := s.clamp * bounds.GetRange(j); limit > 0 {
+				v = math.Max(-limit, math.Min(limit, v))
+			}
+
+			next[j] = x[j] + v
+			velocity[j] = v
+
+			// a particle which leaves the bounds loses its velocity in
+			// that dimension
+			if !bounds.Contains(j, next[j]) {
+				next[j] = bounds.RepairGene(j, next[j], rng)
+				velocity[j] = 0
+			}
+		}
+
+		clone := particle.Clone(rng).(*real.Chromosome)
+		clone.Phenotype = next
+
+		s.steps[i] = velocity
+		moved[i] = clone
+		position[clone] = i
+	}
+
+	// discarded particles are missing from the evaluated slice so they keep
+	// their position and velocity
+	s.EvaluateChromosomes(&moved)
+
+	evaluated := make([]*real.Chromosome, size)
+	for _, chromo := range moved {
+		evaluated[position[chromo]] = chromo.(*real.Chromosome)
+	}
+	s.moved = evaluated
+
+	s.SetOffspring(moved)
+}
+
+// Crossover does nothing since the particles share information through the
+// best positions of their neighbourhoods
+func (s *Solver) Crossover() {}
+
+// Replace moves the particles to their new positions and updates the best
+// position of every particle which improved on it
+func (s *Solver) Replace() {
+
+	defer s.SetOffspring(nil)
+
+	// keep the swarm of the last complete generation when cancelled
+	if s.GetContext().Err() != nil {
+		return
+	}
+
+	obj := s.GetProblem().GetObjective()
+
+	for i, particle := range s.moved {
+		if particle == nil {
+			continue
+		}
+
+		s.particles[i] = particle
+		s.velocities[i] = s.steps[i]
+
+		if obj.IsBetter(particle.GetFitness(), s.bestFitness[i]) {
+			s.bests[i] = append(s.bests[i][:0], particle.Phenotype...)
+			s.bestFitness[i] = particle.GetFitness()
+		}
+	}
+
+	s.SetPopulation(s.swarm())
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// HELPERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// swarm returns the particles as a new population, which the base solver is
+// free to sort without losing track of the particles
+func (s *Solver) swarm() []chromosome.IChromosome {
+	population := make([]chromosome.IChromosome, len(s.particles))
+	for i, particle := range s.particles {
+		population[i] = particle
+	}
+	return population
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// CONSTRUCTOR ////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// NewSolver creates a new particle swarm optimisation Solver with the
+// topology. The defaults are the constant inertia weight of 0.7298 and
+// acceleration coefficients of 1.49618, which behave as the constriction
+// factor for coefficients of 2.05, with the velocity clamped to half of the
+// range of the bounds.
+func NewSolver(topology Topology) solver.ISolver {
+	s := &Solver{}
+	s.ISolver = s
+	s.SetTopology(topology)
+	s.SetInertia(NewConstantInertia(0.7298))
+	s.SetAcceleration(1.49618, 1.49618)
+	s.SetVelocityClamp(0.5)
+	s.SetEvaluationPolicy(solver.Abort)
+	return s
+}
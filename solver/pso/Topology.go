@@ -0,0 +1,63 @@
+package pso
+
+import (
+	"math"
+)
+
+// Topology is a type which defines which particles inform each other of the
+// best positions they have found.
+type Topology string
+
+const (
+	// GlobalBest informs every particle of the best position found by the
+	// whole swarm
+	GlobalBest Topology = "GlobalBest"
+
+	// Ring informs every particle of the best positions of its two
+	// neighbours either side of it in the swarm
+	Ring Topology = "Ring"
+
+	// VonNeumann arranges the swarm row by row in a grid of about as many
+	// columns as rows, wrapped at every edge, and informs every particle of
+	// the best positions of the particles above, below, left and right of it
+	VonNeumann Topology = "VonNeumann"
+)
+
+// Neighbours returns the indices of the particles that inform the particle
+// at the index in a swarm of the size, including the particle itself
+func (t Topology) Neighbours(index int, size int) []int {
+
+	switch t {
+	case Ring:
+		return []int{(index - 1 + size) % size, index, (index + 1) % size}
+	case VonNeumann:
+		columns := int(math.Ceil(math.Sqrt(float64(size))))
+		rows := (size + columns - 1) / columns
+		row, column := index/columns, index%columns
+
+		// the last row may be partly filled, so rows wrap over the particles
+		// they hold and columns over the rows which reach them
+		width := columns
+		if row == rows-1 {
+			width = size - row*columns
+		}
+		height := rows
+		if column >= size-(rows-1)*columns {
+			height = rows - 1
+		}
+
+		return []int{
+			index,
+			row*columns + (column-1+width)%width,
+			row*columns + (column+1)%width,
+			((row-1+height)%height)*columns + column,
+			((row+1)%height)*columns + column,
+		}
+	default:
+		all := make([]int, size)
+		for i := range all {
+			all[i] = i
+		}
+		return all
+	}
+}
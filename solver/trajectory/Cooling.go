@@ -0,0 +1,106 @@
+package trajectory
+
+import (
+	"math"
+)
+
+// GeometricCooling multiplies the temperature by a constant rate below 1
+// after every step
+type GeometricCooling struct {
+	rate        float64
+	temperature float64
+}
+
+// Reset sets the temperature
+func (g *GeometricCooling) Reset(temperature float64) {
+	g.temperature = temperature
+}
+
+// Next returns the cooled temperature
+func (g *GeometricCooling) Next(accepted bool) float64 {
+	g.temperature *= g.rate
+	return g.temperature
+}
+
+// NewGeometricCooling creates a new instance of the GeometricCooling
+func NewGeometricCooling(rate float64) ICooling {
+	return &GeometricCooling{rate: rate}
+}
+
+// LogarithmicCooling divides the initial temperature by the logarithm of the
+// number of steps taken (Geman and Geman, 1984). It cools slowly enough to
+// find the global optimum in theory and is usually too slow in practice.
+type LogarithmicCooling struct {
+	initial float64
+	steps   int
+}
+
+// Reset sets the initial temperature and clears the steps
+func (l *LogarithmicCooling) Reset(temperature float64) {
+	l.initial = temperature
+	l.steps = 0
+}
+
+// Next returns the temperature after another step
+func (l *LogarithmicCooling) Next(accepted bool) float64 {
+	l.steps++
+	return l.initial / math.Log(math.E+float64(l.steps))
+}
+
+// NewLogarithmicCooling creates a new instance of the LogarithmicCooling
+func NewLogarithmicCooling() ICooling {
+	return &LogarithmicCooling{}
+}
+
+// AdaptiveCooling steers the temperature towards a target acceptance ratio
+// which itself decays geometrically. After every window of steps the
+// temperature is cooled by the rate when more candidates were accepted than
+// the target and warmed by it when fewer were, so the temperature follows
+// the scale of the fitness landscape instead of a fixed timetable.
+type AdaptiveCooling struct {
+	target      float64
+	window      int
+	rate        float64
+	ratio       float64
+	temperature float64
+	steps       int
+	accepted    int
+}
+
+// Reset sets the temperature and starts the target acceptance ratio again
+func (a *AdaptiveCooling) Reset(temperature float64) {
+	a.temperature = temperature
+	a.ratio = a.target
+	a.steps = 0
+	a.accepted = 0
+}
+
+// Next returns the temperature of the next step, adapting it at the end of
+// every window
+func (a *AdaptiveCooling) Next(accepted bool) float64 {
+
+	a.steps++
+	if accepted {
+		a.accepted++
+	}
+
+	if a.steps >= a.window {
+		if float64(a.accepted)/float64(a.steps) > a.ratio {
+			a.temperature *= a.rate
+		} else {
+			a.temperature /= a.rate
+		}
+		a.ratio *= a.rate
+		a.steps = 0
+		a.accepted = 0
+	}
+
+	return a.temperature
+}
+
+// NewAdaptiveCooling creates a new instance of the AdaptiveCooling which
+// starts from the target acceptance ratio and adapts every window of steps
+// by the rate below 1
+func NewAdaptiveCooling(target float64, window int, rate float64) ICooling {
+	return &AdaptiveCooling{target: target, window: window, rate: rate}
+}
@@ -0,0 +1,92 @@
+package trajectory
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/objective"
+)
+
+// HillClimbing moves to every candidate which is at least as good as the
+// current chromosome, which lets it drift across plateaus
+type HillClimbing struct {
+	probability float64
+}
+
+// Reset sets the mutation probability of the candidates
+func (h *HillClimbing) Reset(probability float64) {
+	h.probability = probability
+}
+
+// Probability returns the mutation probability of the next candidate
+func (h *HillClimbing) Probability() float64 {
+	return h.probability
+}
+
+// Accept returns true when the candidate is at least as good as the current
+// chromosome
+func (h *HillClimbing) Accept(current chromosome.IChromosome, candidate chromosome.IChromosome, obj objective.Objective, rng generator.IGenerator) bool {
+	return !obj.IsBetter(current.GetFitness(), candidate.GetFitness())
+}
+
+// Restart returns false since the climb never restarts
+func (h *HillClimbing) Restart() bool {
+	return false
+}
+
+// NewHillClimbing creates a new instance of the HillClimbing strategy
+func NewHillClimbing() IStrategy {
+	return &HillClimbing{}
+}
+
+// RandomRestartHillClimbing climbs like HillClimbing and restarts from a
+// random chromosome once a number of steps in a row have failed to improve
+// on the current chromosome
+type RandomRestartHillClimbing struct {
+	HillClimbing
+	patience int
+	stalled  int
+}
+
+// SetPatience sets the number of steps without improvement after which the
+// climb restarts
+func (r *RandomRestartHillClimbing) SetPatience(patience int) {
+	r.patience = patience
+}
+
+// GetPatience returns the number of steps without improvement after which
+// the climb restarts
+func (r *RandomRestartHillClimbing) GetPatience() int {
+	return r.patience
+}
+
+// Reset sets the mutation probability of the candidates and starts a new
+// climb
+func (r *RandomRestartHillClimbing) Reset(probability float64) {
+	r.HillClimbing.Reset(probability)
+	r.stalled = 0
+}
+
+// Accept returns true when the candidate is at least as good as the current
+// chromosome, counting the steps which failed to improve on it
+func (r *RandomRestartHillClimbing) Accept(current chromosome.IChromosome, candidate chromosome.IChromosome, obj objective.Objective, rng generator.IGenerator) bool {
+	if obj.IsBetter(candidate.GetFitness(), current.GetFitness()) {
+		r.stalled = 0
+	} else {
+		r.stalled++
+	}
+	return r.HillClimbing.Accept(current, candidate, obj, rng)
+}
+
+// Restart returns true when the climb has stalled for longer than its
+// patience
+func (r *RandomRestartHillClimbing) Restart() bool {
+	return r.stalled >= r.patience
+}
+
+// NewRandomRestartHillClimbing creates a new instance of the
+// RandomRestartHillClimbing strategy which restarts after the patience
+func NewRandomRestartHillClimbing(patience int) IStrategy {
+	r := &RandomRestartHillClimbing{}
+	r.SetPatience(patience)
+	return r
+}
@@ -0,0 +1,10 @@
+package trajectory
+
+// ICooling is the interface for the cooling schedules of simulated
+// annealing. The schedule is reset to the initial temperature at the start of
+// every run and told after every step whether the candidate was accepted,
+// returning the temperature of the next step.
+type ICooling interface {
+	Reset(temperature float64)
+	Next(accepted bool) float64
+}
@@ -0,0 +1,19 @@
+package trajectory
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/objective"
+)
+
+// IStrategy is the interface for the strategies which steer a trajectory
+// solver. In every step the solver asks the strategy for the mutation
+// probability of the candidate, then whether the current chromosome moves to
+// the evaluated candidate and finally whether the search restarts from a
+// random chromosome.
+type IStrategy interface {
+	Reset(probability float64)
+	Probability() float64
+	Accept(current chromosome.IChromosome, candidate chromosome.IChromosome, obj objective.Objective, rng generator.IGenerator) bool
+	Restart() bool
+}
@@ -0,0 +1,87 @@
+package trajectory
+
+import (
+	"math"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/objective"
+)
+
+// OnePlusOne is the (1+1) evolution strategy, which keeps the better of the
+// parent and its single offspring and adapts the mutation strength by the
+// 1/5th success rule of Rechenberg. After every window of steps the mutation
+// probability grows when more than a fifth of the offspring improved on their
+// parent and shrinks otherwise, though never below one over the dimensions
+// of the chromosome, so that offspring keep changing at least one gene on
+// average.
+type OnePlusOne struct {
+	HillClimbing
+	window    int
+	factor    float64
+	steps     int
+	successes int
+}
+
+// SetWindow sets the number of steps between adaptations of the mutation
+// probability
+func (o *OnePlusOne) SetWindow(window int) {
+	o.window = window
+}
+
+// SetFactor sets the factor below 1 the mutation probability is multiplied
+// by when it shrinks and divided by when it grows
+func (o *OnePlusOne) SetFactor(factor float64) {
+	o.factor = factor
+}
+
+// GetWindow returns the number of steps between adaptations of the mutation
+// probability
+func (o *OnePlusOne) GetWindow() int {
+	return o.window
+}
+
+// GetFactor returns the factor the mutation probability changes by
+func (o *OnePlusOne) GetFactor() float64 {
+	return o.factor
+}
+
+// Reset sets the mutation probability of the candidates and clears the
+// successes
+func (o *OnePlusOne) Reset(probability float64) {
+	o.HillClimbing.Reset(probability)
+	o.steps = 0
+	o.successes = 0
+}
+
+// Accept returns true when the candidate is at least as good as the current
+// chromosome and adapts the mutation probability at the end of every window
+func (o *OnePlusOne) Accept(current chromosome.IChromosome, candidate chromosome.IChromosome, obj objective.Objective, rng generator.IGenerator) bool {
+
+	o.steps++
+	if obj.IsBetter(candidate.GetFitness(), current.GetFitness()) {
+		o.successes++
+	}
+
+	if o.steps >= o.window {
+		if float64(o.successes)/float64(o.steps) > 0.2 {
+			o.probability = math.Min(1, o.probability/o.factor)
+		} else {
+			floor := math.Min(o.probability, 1/float64(current.GetDimensions()))
+			o.probability = math.Max(floor, o.probability*o.factor)
+		}
+		o.steps = 0
+		o.successes = 0
+	}
+
+	return o.HillClimbing.Accept(current, candidate, obj, rng)
+}
+
+// NewOnePlusOne creates a new instance of the OnePlusOne strategy which adapts
+// the mutation probability every 10 steps by a factor of 0.85
+func NewOnePlusOne() IStrategy {
+	o := &OnePlusOne{}
+	o.SetWindow(10)
+	o.SetFactor(0.85)
+	return o
+}
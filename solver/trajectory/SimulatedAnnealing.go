@@ -0,0 +1,72 @@
+package trajectory
+
+import (
+	"math"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/objective"
+)
+
+// SimulatedAnnealing moves to every candidate which is at least as good as
+// the current chromosome and to a worse candidate with the probability
+// exp(-delta / temperature) of the Metropolis criterion, where the
+// temperature falls according to the cooling schedule
+type SimulatedAnnealing struct {
+	HillClimbing
+	initial     float64
+	temperature float64
+	cooling     ICooling
+}
+
+// SetTemperature sets the initial temperature, which is on the scale of the
+// fitness differences the search should accept at the start
+func (s *SimulatedAnnealing) SetTemperature(temperature float64) {
+	s.initial = temperature
+}
+
+// SetCooling sets the cooling schedule
+func (s *SimulatedAnnealing) SetCooling(cooling ICooling) {
+	s.cooling = cooling
+}
+
+// GetTemperature returns the current temperature
+func (s *SimulatedAnnealing) GetTemperature() float64 {
+	return s.temperature
+}
+
+// GetCooling returns the cooling schedule
+func (s *SimulatedAnnealing) GetCooling() ICooling {
+	return s.cooling
+}
+
+// Reset sets the mutation probability of the candidates and heats the
+// schedule to the initial temperature
+func (s *SimulatedAnnealing) Reset(probability float64) {
+	s.HillClimbing.Reset(probability)
+	s.temperature = s.initial
+	s.cooling.Reset(s.initial)
+}
+
+// Accept applies the Metropolis criterion to the candidate and cools the
+// temperature
+func (s *SimulatedAnnealing) Accept(current chromosome.IChromosome, candidate chromosome.IChromosome, obj objective.Objective, rng generator.IGenerator) bool {
+
+	accepted := s.HillClimbing.Accept(current, candidate, obj, rng)
+	if !accepted && s.temperature > 0 {
+		delta := math.Abs(candidate.GetFitness() - current.GetFitness())
+		accepted = rng.Float64() < math.Exp(-delta/s.temperature)
+	}
+
+	s.temperature = s.cooling.Next(accepted)
+	return accepted
+}
+
+// NewSimulatedAnnealing creates a new instance of the SimulatedAnnealing
+// strategy with the initial temperature and cooling schedule
+func NewSimulatedAnnealing(temperature float64, cooling ICooling) IStrategy {
+	s := &SimulatedAnnealing{}
+	s.SetTemperature(temperature)
+	s.SetCooling(cooling)
+	return s
+}
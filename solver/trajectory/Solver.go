@@ -0,0 +1,241 @@
+package trajectory
+
+import (
+	"errors"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/solver"
+)
+
+// streamCandidate separates the seeds of the candidates from the streams of
+// the base solver
+const streamCandidate int64 = 401
+
+// Solver implements single point search, where the population is the
+// current chromosome of a trajectory through the search space. In every step
+// the current chromosome is cloned and mutated into candidates, the best
+// candidate is evaluated against it by the strategy and the trajectory moves
+// when the strategy accepts the candidate. The best chromosome of the run is
+// kept by the solver even when the trajectory moves away from it.
+type Solver struct {
+	solver.Solver
+	strategy    IStrategy
+	neighbours  int
+	probability float64
+
+	current  chromosome.IChromosome
+	restarts int
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// SETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// SetStrategy sets the strategy which steers the trajectory
+func (s *Solver) SetStrategy(strategy IStrategy) {
+	s.strategy = strategy
+}
+
+// SetNeighbours sets the number of candidates evaluated in every step. More
+// than one candidate lets the workers of the solver evaluate them in
+// parallel.
+func (s *Solver) SetNeighbours(neighbours int) {
+	s.neighbours = neighbours
+}
+
+// SetMutationProbability sets the initial probability the candidates are
+// mutated with, where zero uses one over the dimensions of the problem
+func (s *Solver) SetMutationProbability(probability float64) {
+	s.probability = probability
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// GETTERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// GetStrategy returns the strategy which steers the trajectory
+func (s *Solver) GetStrategy() IStrategy {
+	return s.strategy
+}
+
+// GetNeighbours returns the number of candidates evaluated in every step
+func (s *Solver) GetNeighbours() int {
+	return s.neighbours
+}
+
+// GetMutationProbability returns the initial probability the candidates are
+// mutated with
+func (s *Solver) GetMutationProbability() float64 {
+	return s.probability
+}
+
+// GetCurrent returns the current chromosome of the trajectory
+func (s *Solver) GetCurrent() chromosome.IChromosome {
+	return s.current
+}
+
+// GetRestarts returns the number of restarts of the current run
+func (s *Solver) GetRestarts() int {
+	return s.restarts
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// ISOLVER IMPLEMENTATIONS ////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// Validate checks the configuration of the base solver, the strategy and
+// that the population holds the single current chromosome
+func (s *Solver) Validate() error {
+
+	errs := []error{s.Solver.Validate()}
+
+	invalid := func(field string, value interface{}, err error, reason string) {
+		errs = append(errs, &solver.ValidationError{Field: field, Value: value, Reason: reason, Err: err})
+	}
+
+	// the base solver reports a population smaller than 1
+	if s.GetPopulationSize() > 1 {
+		invalid("populationSize", s.GetPopulationSize(), solver.ErrInvalidPopulationSize, "a trajectory holds a single chromosome")
+	}
+
+	if s.neighbours < 1 {
+		invalid("neighbours", s.neighbours, solver.ErrIncompatibleStrategy, "every step needs at least 1 candidate")
+	}
+
+	if s.probability < 0 || s.probability > 1 {
+		invalid("probability", s.probability, solver.ErrInvalidRate, "the probability must be between 0 and 1")
+	}
+
+	switch strategy := s.strategy.(type) {
+	case nil:
+		invalid("strategy", nil, solver.ErrIncompatibleStrategy, "call SetStrategy before running the solver")
+	case *SimulatedAnnealing:
+		if strategy.initial <= 0 {
+			invalid("temperature", strategy.initial, solver.ErrIncompatibleStrategy, "the initial temperature must be positive")
+		}
+		if strategy.GetCooling() == nil {
+			invalid("cooling", nil, solver.ErrIncompatibleStrategy, "call SetCooling before running the solver")
+		}
+	case *RandomRestartHillClimbing:
+		if strategy.GetPatience() < 1 {
+			invalid("patience", strategy.GetPatience(), solver.ErrIncompatibleStrategy, "the patience must be at least 1 step")
+		}
+	case *OnePlusOne:
+		if strategy.GetWindow() < 1 {
+			invalid("window", strategy.GetWindow(), solver.ErrIncompatibleStrategy, "the window must be at least 1 step")
+		}
+		if strategy.GetFactor() <= 0 || strategy.GetFactor() >= 1 {
+			invalid("factor", strategy.GetFactor(), solver.ErrInvalidRate, "the factor must be between 0 and 1")
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Initialise generates and evaluates the first chromosome of the trajectory
+// and resets the strategy
+func (s *Solver) Initialise() {
+	s.restarts = 0
+	s.current = nil
+	s.restart()
+}
+
+// Mutate clones and mutates the current chromosome into the candidates and
+// evaluates them
+func (s *Solver) Mutate() {
+
+	if s.current == nil {
+		return
+	}
+
+	probability := s.strategy.Probability()
+	candidates := make([]chromosome.IChromosome, s.neighbours)
+
+	s.GetPool().Run(len(candidates), func(pos int) {
+		rng := generator.Derive(s.GetProblem().GetGenerator(), streamCandidate, int64(s.GetGeneration()), int64(pos))
+		candidate := s.current.Clone(rng)
+		candidate.Mutate(probability)
+		candidates[pos] = candidate
+	})
+
+	s.EvaluateChromosomes(&candidates)
+	s.SetOffspring(candidates)
+}
+
+// Crossover does nothing since a trajectory has a single chromosome
+func (s *Solver) Crossover() {}
+
+// Replace moves the trajectory to the best candidate when the strategy
+// accepts it and restarts the trajectory when the strategy asks to
+func (s *Solver) Replace() {
+
+	candidates := s.GetOffspring()
+	s.SetOffspring(nil)
+
+	// keep the chromosome of the last complete step when cancelled
+	if s.GetContext().Err() != nil {
+		return
+	}
+
+	if len(candidates) > 0 {
+		s.SortChromosomes(&candidates)
+		if s.current == nil || s.strategy.Accept(s.current, candidates[0], s.GetProblem().GetObjective(), s.GetGenerator()) {
+			s.current = candidates[0]
+		}
+	}
+
+	if s.current == nil || s.strategy.Restart() {
+		s.restarts++
+		s.restart()
+		return
+	}
+
+	s.SetPopulation([]chromosome.IChromosome{s.current})
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// HELPERS ////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// restart moves the trajectory to a new random chromosome and resets the
+// strategy
+func (s *Solver) restart() {
+
+	probability := s.probability
+	if probability == 0 {
+		probability = 1 / float64(s.GetProblem().GetDimensions())
+	}
+	s.strategy.Reset(probability)
+
+	restarted := s.GenerateChromosomes(1)
+	s.EvaluateChromosomes(&restarted)
+
+	// a discarded chromosome leaves the trajectory where it was, or empty
+	// until a later step succeeds
+	if len(restarted) == 1 {
+		s.current = restarted[0]
+	}
+
+	if s.current != nil {
+		s.SetPopulation([]chromosome.IChromosome{s.current})
+	}
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// CONSTRUCTOR ////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// NewSolver creates a new trajectory Solver with the strategy, such as
+// NewHillClimbing(), NewRandomRestartHillClimbing(patience), NewOnePlusOne()
+// or NewSimulatedAnnealing(temperature, cooling). Every step evaluates a
+// single candidate mutated with a probability of one over the dimensions.
+func NewSolver(strategy IStrategy) solver.ISolver {
+	s := &Solver{}
+	s.ISolver = s
+	s.SetStrategy(strategy)
+	s.SetPopulationSize(1)
+	s.SetNeighbours(1)
+	s.SetEvaluationPolicy(solver.Abort)
+	return s
+}
@@ -0,0 +1,139 @@
+package stats
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/objective"
+)
+
+// Statistics is a snapshot of the progress of a solver at the end of a
+// generation.
+type Statistics struct {
+	Generation   int
+	Evaluations  int
+	Elapsed      time.Duration
+	Improvements int
+
+	BestFitness   float64
+	WorstFitness  float64
+	MeanFitness   float64
+	MedianFitness float64
+	StdDevFitness float64
+
+	// Failures is the number of chromosomes without a finite fitness, such as
+	// those which failed to evaluate, which the fitness statistics leave out
+	Failures int
+
+	// Diversity is the mean distance between every pair of chromosomes and
+	// is only available when the chromosomes implement IDistance and the
+	// diversity was measured
+	Diversity    float64
+	HasDiversity bool
+}
+
+// NewStatistics computes the fitness statistics and diversity of the
+// population with respect to the objective. The generation, evaluations,
+// elapsed time and improvements are left for the solver to fill in.
+func NewStatistics(population []chromosome.IChromosome, obj objective.Objective) Statistics {
+	statistics := NewFitnessStatistics(population, obj)
+	statistics.Diversity, statistics.HasDiversity = Diversity(population)
+	return statistics
+}
+
+// NewFitnessStatistics computes the fitness statistics of the population with
+// respect to the objective, leaving out the diversity since measuring it
+// costs a distance for every pair of chromosomes. Fitnesses which are not
+// finite are counted as failures rather than skewing the statistics.
+func NewFitnessStatistics(population []chromosome.IChromosome, obj objective.Objective) Statistics {
+
+	statistics := Statistics{}
+
+	fitnesses := make([]float64, 0, len(population))
+
+	total := 0.0
+	for _, c := range population {
+		fitness := c.GetFitness()
+		if math.IsInf(fitness, 0) || math.IsNaN(fitness) {
+			statistics.Failures++
+			continue
+		}
+		if len(fitnesses) == 0 || obj.IsBetter(fitness, statistics.BestFitness) {
+			statistics.BestFitness = fitness
+		}
+		if len(fitnesses) == 0 || obj.IsBetter(statistics.WorstFitness, fitness) {
+			statistics.WorstFitness = fitness
+		}
+		fitnesses = append(fitnesses, fitness)
+		total += fitness
+	}
+
+	if len(fitnesses) == 0 {
+		return statistics
+	}
+
+	statistics.MeanFitness = total / float64(len(fitnesses))
+	statistics.MedianFitness = Median(fitnesses)
+	statistics.StdDevFitness = StdDev(fitnesses, statistics.MeanFitness)
+
+	return statistics
+}
+
+// Median returns the median of the values. The values are sorted in place.
+func Median(values []float64) float64 {
+
+	if len(values) == 0 {
+		return 0
+	}
+
+	sort.Float64s(values)
+
+	middle := len(values) / 2
+	if len(values)%2 == 0 {
+		return (values[middle-1] + values[middle]) / 2
+	}
+	return values[middle]
+}
+
+// StdDev returns the population standard deviation of the values given their
+// mean
+func StdDev(values []float64, mean float64) float64 {
+
+	if len(values) == 0 {
+		return 0
+	}
+
+	variance := 0.0
+	for _, value := range values {
+		variance += (value - mean) * (value - mean)
+	}
+
+	return math.Sqrt(variance / float64(len(values)))
+}
+
+// Diversity returns the mean distance between every pair of chromosomes in
+// the population. The second value is false when the chromosomes do not
+// implement the IDistance interface or there are fewer than two of them.
+func Diversity(population []chromosome.IChromosome) (float64, bool) {
+
+	if len(population) < 2 {
+		return 0, false
+	}
+
+	if _, ok := population[0].(chromosome.IDistance); !ok {
+		return 0, false
+	}
+
+	total := 0.0
+	pairs := 0
+	for i := 0; i < len(population); i++ {
+		for j := i + 1; j < len(population); j++ {
+			total += population[i].(chromosome.IDistance).Distance(population[j])
+			pairs++
+		}
+	}
+
+	return total / float64(pairs), true
+}
@@ -0,0 +1,80 @@
+package termination
+
+import (
+	"strings"
+)
+
+// AnyCriterion combines criteria with a logical OR and is met as soon as one
+// of them is met. Every criterion is checked each generation so that
+// stateful criteria keep track of the run.
+type AnyCriterion struct {
+	criteria []ICriterion
+	reasons  []string
+}
+
+// Reset resets every criterion
+func (a *AnyCriterion) Reset() {
+	a.reasons = nil
+	for _, criterion := range a.criteria {
+		criterion.Reset()
+	}
+}
+
+// Met returns true when any of the criteria are met
+func (a *AnyCriterion) Met(state IState) bool {
+	a.reasons = nil
+	for _, criterion := range a.criteria {
+		if criterion.Met(state) {
+			a.reasons = append(a.reasons, criterion.Reason())
+		}
+	}
+	return len(a.reasons) > 0
+}
+
+// Reason describes the criteria which were met
+func (a *AnyCriterion) Reason() string {
+	return strings.Join(a.reasons, " or ")
+}
+
+// Or creates a criterion which is met when any of the criteria are met
+func Or(criteria ...ICriterion) ICriterion {
+	return &AnyCriterion{criteria: criteria}
+}
+
+// AllCriterion combines criteria with a logical AND and is only met when all
+// of them are met in the same generation.
+type AllCriterion struct {
+	criteria []ICriterion
+}
+
+// Reset resets every criterion
+func (a *AllCriterion) Reset() {
+	for _, criterion := range a.criteria {
+		criterion.Reset()
+	}
+}
+
+// Met returns true when all of the criteria are met
+func (a *AllCriterion) Met(state IState) bool {
+	met := len(a.criteria) > 0
+	for _, criterion := range a.criteria {
+		if !criterion.Met(state) {
+			met = false
+		}
+	}
+	return met
+}
+
+// Reason describes all of the criteria
+func (a *AllCriterion) Reason() string {
+	reasons := make([]string, len(a.criteria))
+	for i, criterion := range a.criteria {
+		reasons[i] = criterion.Reason()
+	}
+	return strings.Join(reasons, " and ")
+}
+
+// And creates a criterion which is met when all of the criteria are met
+func And(criteria ...ICriterion) ICriterion {
+	return &AllCriterion{criteria: criteria}
+}
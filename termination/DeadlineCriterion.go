@@ -0,0 +1,29 @@
+package termination
+
+import (
+	"fmt"
+	"time"
+)
+
+// DeadlineCriterion is met once the wall clock passes the deadline
+type DeadlineCriterion struct {
+	deadline time.Time
+}
+
+// Reset has nothing to reset
+func (d *DeadlineCriterion) Reset() {}
+
+// Met returns true when the deadline has passed
+func (d *DeadlineCriterion) Met(state IState) bool {
+	return !time.Now().Before(d.deadline)
+}
+
+// Reason describes the criterion
+func (d *DeadlineCriterion) Reason() string {
+	return fmt.Sprintf("deadline of %v passed", d.deadline.Format(time.RFC3339))
+}
+
+// NewDeadlineCriterion creates a new instance of the DeadlineCriterion
+func NewDeadlineCriterion(deadline time.Time) ICriterion {
+	return &DeadlineCriterion{deadline: deadline}
+}
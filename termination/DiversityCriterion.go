@@ -0,0 +1,44 @@
+package termination
+
+import (
+	"fmt"
+
+	"github.com/opticverge/goevolution/stats"
+)
+
+// DiversityCriterion is met when the diversity of the population falls below
+// a threshold. When the chromosomes implement the IDistance interface the
+// diversity is the mean distance between every pair of chromosomes,
+// otherwise it is the standard deviation of the fitness of the population.
+type DiversityCriterion struct {
+	threshold float64
+}
+
+// Reset has nothing to reset
+func (d *DiversityCriterion) Reset() {}
+
+// Met returns true when the diversity is below the threshold
+func (d *DiversityCriterion) Met(state IState) bool {
+
+	population := state.GetPopulation()
+	if len(population) < 2 {
+		return false
+	}
+
+	statistics := stats.NewStatistics(population, state.GetProblem().GetObjective())
+	if statistics.HasDiversity {
+		return statistics.Diversity < d.threshold
+	}
+
+	return statistics.StdDevFitness < d.threshold
+}
+
+// Reason describes the criterion
+func (d *DiversityCriterion) Reason() string {
+	return fmt.Sprintf("population diversity below %v", d.threshold)
+}
+
+// NewDiversityCriterion creates a new instance of the DiversityCriterion
+func NewDiversityCriterion(threshold float64) ICriterion {
+	return &DiversityCriterion{threshold: threshold}
+}
@@ -0,0 +1,28 @@
+package termination
+
+import (
+	"time"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/problem"
+)
+
+// ICriterion represents the interface for termination criteria. The solver
+// resets every criterion at the start of a run and checks it at the end of
+// each generation. Once a criterion is met its Reason describes why, so that
+// the solver can report which criterion ended the run.
+type ICriterion interface {
+	Reset()
+	Met(IState) bool
+	Reason() string
+}
+
+// IState is the view of a solver that a criterion is able to inspect. The
+// population is expected to be sorted from best to worst.
+type IState interface {
+	GetGeneration() int
+	GetEvaluations() int
+	GetElapsed() time.Duration
+	GetPopulation() []chromosome.IChromosome
+	GetProblem() problem.IProblem
+}
@@ -0,0 +1,30 @@
+package termination
+
+import (
+	"fmt"
+)
+
+// MaxEvaluationsCriterion is met once the objective function has been
+// evaluated a number of times
+type MaxEvaluationsCriterion struct {
+	evaluations int
+}
+
+// Reset has nothing to reset
+func (m *MaxEvaluationsCriterion) Reset() {}
+
+// Met returns true when the number of evaluations has been reached
+func (m *MaxEvaluationsCriterion) Met(state IState) bool {
+	return state.GetEvaluations() >= m.evaluations
+}
+
+// Reason describes the criterion
+func (m *MaxEvaluationsCriterion) Reason() string {
+	return fmt.Sprintf("maximum of %v evaluations reached", m.evaluations)
+}
+
+// NewMaxEvaluationsCriterion creates a new instance of the
+// MaxEvaluationsCriterion
+func NewMaxEvaluationsCriterion(evaluations int) ICriterion {
+	return &MaxEvaluationsCriterion{evaluations: evaluations}
+}
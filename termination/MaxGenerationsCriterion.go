@@ -0,0 +1,29 @@
+package termination
+
+import (
+	"fmt"
+)
+
+// MaxGenerationsCriterion is met once the solver reaches a generation
+type MaxGenerationsCriterion struct {
+	generations int
+}
+
+// Reset has nothing to reset
+func (m *MaxGenerationsCriterion) Reset() {}
+
+// Met returns true when the generation has been reached
+func (m *MaxGenerationsCriterion) Met(state IState) bool {
+	return state.GetGeneration() >= m.generations
+}
+
+// Reason describes the criterion
+func (m *MaxGenerationsCriterion) Reason() string {
+	return fmt.Sprintf("maximum of %v generations reached", m.generations)
+}
+
+// NewMaxGenerationsCriterion creates a new instance of the
+// MaxGenerationsCriterion
+func NewMaxGenerationsCriterion(generations int) ICriterion {
+	return &MaxGenerationsCriterion{generations: generations}
+}
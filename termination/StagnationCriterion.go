@@ -0,0 +1,52 @@
+package termination
+
+import (
+	"fmt"
+)
+
+// StagnationCriterion is met when the best fitness in the population has not
+// improved for a number of generations.
+type StagnationCriterion struct {
+	generations int
+	best        float64
+	stagnant    int
+	started     bool
+}
+
+// Reset forgets the best fitness seen so far
+func (s *StagnationCriterion) Reset() {
+	s.stagnant = 0
+	s.started = false
+}
+
+// Met returns true once the best fitness has stagnated
+func (s *StagnationCriterion) Met(state IState) bool {
+
+	population := state.GetPopulation()
+	if len(population) == 0 {
+		return false
+	}
+
+	fitness := population[0].GetFitness()
+
+	if !s.started || state.GetProblem().GetObjective().IsBetter(fitness, s.best) {
+		s.best = fitness
+		s.stagnant = 0
+		s.started = true
+		return false
+	}
+
+	s.stagnant++
+
+	return s.stagnant >= s.generations
+}
+
+// Reason describes the criterion
+func (s *StagnationCriterion) Reason() string {
+	return fmt.Sprintf("no improvement for %v generations", s.generations)
+}
+
+// NewStagnationCriterion creates a new instance of the StagnationCriterion
+func NewStagnationCriterion(generations int) ICriterion {
+	return &StagnationCriterion{generations: generations}
+}
@@ -0,0 +1,35 @@
+package termination
+
+import (
+	"fmt"
+)
+
+// TargetFitnessCriterion is met once the best chromosome in the population
+// is at least as good as the target fitness with respect to the objective of
+// the problem.
+type TargetFitnessCriterion struct {
+	target float64
+}
+
+// Reset has nothing to reset
+func (t *TargetFitnessCriterion) Reset() {}
+
+// Met returns true when the target fitness has been reached
+func (t *TargetFitnessCriterion) Met(state IState) bool {
+	population := state.GetPopulation()
+	if len(population) == 0 {
+		return false
+	}
+	return !state.GetProblem().GetObjective().IsBetter(t.target, population[0].GetFitness())
+}
+
+// Reason describes the criterion
+func (t *TargetFitnessCriterion) Reason() string {
+	return fmt.Sprintf("target fitness of %v reached", t.target)
+}
+
+// NewTargetFitnessCriterion creates a new instance of the
+// TargetFitnessCriterion
+func NewTargetFitnessCriterion(target float64) ICriterion {
+	return &TargetFitnessCriterion{target: target}
+}
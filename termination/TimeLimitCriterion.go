@@ -0,0 +1,30 @@
+package termination
+
+import (
+	"fmt"
+	"time"
+)
+
+// TimeLimitCriterion is met once the solver has been running for longer than
+// the limit
+type TimeLimitCriterion struct {
+	limit time.Duration
+}
+
+// Reset has nothing to reset
+func (t *TimeLimitCriterion) Reset() {}
+
+// Met returns true when the time limit has elapsed
+func (t *TimeLimitCriterion) Met(state IState) bool {
+	return state.GetElapsed() >= t.limit
+}
+
+// Reason describes the criterion
+func (t *TimeLimitCriterion) Reason() string {
+	return fmt.Sprintf("time limit of %v elapsed", t.limit)
+}
+
+// NewTimeLimitCriterion creates a new instance of the TimeLimitCriterion
+func NewTimeLimitCriterion(limit time.Duration) ICriterion {
+	return &TimeLimitCriterion{limit: limit}
+}
@@ -0,0 +1,157 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/chromosome/binary"
+	"github.com/opticverge/goevolution/examples/onemax"
+	"github.com/opticverge/goevolution/generator"
+)
+
+func TestBitsSetGetAndCount(t *testing.T) {
+
+	// GIVEN
+	bits := binary.NewBits(130)
+
+	// WHEN
+	bits.Set(0, true)
+	bits.Set(64, true)
+	bits.Set(129, true)
+	bits.Flip(129)
+	bits.Flip(100)
+
+	// THEN
+	if !bits.Get(0) || !bits.Get(64) || !bits.Get(100) || bits.Get(129) {
+		t.Errorf("Expected bits 0, 64 and 100 to be set, Actual %v", bits)
+	}
+
+	if bits.Count() != 3 {
+		t.Errorf("Expected count to be %v, Actual %v", 3, bits.Count())
+	}
+}
+
+func TestBitsHamming(t *testing.T) {
+
+	// GIVEN
+	first := binary.NewBits(200)
+	second := binary.NewBits(200)
+	first.Set(3, true)
+	first.Set(150, true)
+	second.Set(150, true)
+	second.Set(199, true)
+
+	// WHEN
+	distance := first.Hamming(second)
+
+	// THEN
+	if distance != 2 {
+		t.Errorf("Expected hamming distance to be %v, Actual %v", 2, distance)
+	}
+}
+
+func TestBitsRandomiseClearsUnusedBits(t *testing.T) {
+
+	// GIVEN
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	bits := binary.NewBits(70)
+
+	// WHEN
+	bits.Randomise(rng)
+
+	// THEN
+	if words := bits.Words(); words[1]>>6 != 0 {
+		t.Errorf("Expected bits beyond the length to be zero, Actual %b", words[1])
+	}
+}
+
+func TestBitFlipMutationExtremes(t *testing.T) {
+
+	// GIVEN
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	bits := binary.NewBits(100)
+
+	// WHEN
+	binary.BitFlipMutation(bits, 0, rng)
+	unchanged := bits.Count()
+	binary.BitFlipMutation(bits, 1, rng)
+
+	// THEN
+	if unchanged != 0 || bits.Count() != 100 {
+		t.Errorf("Expected %v and %v bits set, Actual %v and %v", 0, 100, unchanged, bits.Count())
+	}
+}
+
+func TestBitFlipMutationRate(t *testing.T) {
+
+	// GIVEN
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	bits := binary.NewBits(100000)
+
+	// WHEN
+	binary.BitFlipMutation(bits, 0.01, rng)
+
+	// THEN
+	if bits.Count() < 800 || bits.Count() > 1200 {
+		t.Errorf("Expected around %v bits to flip, Actual %v", 1000, bits.Count())
+	}
+}
+
+func TestBinaryCrossoversPreserveBits(t *testing.T) {
+
+	// GIVEN
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	first := binary.NewBits(150)
+	second := binary.NewBits(150)
+	first.Randomise(rng)
+	second.Randomise(rng)
+
+	crossovers := []binary.ICrossover{
+		binary.NewOnePointCrossover(),
+		binary.NewTwoPointCrossover(),
+		binary.NewUniformCrossover(),
+	}
+
+	for _, crossover := range crossovers {
+
+		// WHEN
+		children := crossover.Crossover(first, second, rng)
+
+		// THEN
+		for i := 0; i < first.Len(); i++ {
+			if ones(children[0].Get(i), children[1].Get(i)) != ones(first.Get(i), second.Get(i)) {
+				t.Fatalf("Expected %T to inherit bit %v from the parents", crossover, i)
+			}
+		}
+
+		if children[0].Hamming(first)+children[0].Hamming(second) != first.Hamming(second) {
+			t.Errorf("Expected %T child to lie between the parents", crossover)
+		}
+	}
+}
+
+func TestOneMaxInitialPopulationIsDiverse(t *testing.T) {
+
+	// GIVEN
+	p := onemax.NewProblem()
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	p.SetDimensions(16)
+
+	// WHEN
+	population := make([]chromosome.IChromosome, 10)
+	for i := range population {
+		population[i] = p.GenerateChromosome()
+		population[i].Generate()
+	}
+
+	// THEN
+	genomes := map[string]bool{}
+	for _, chromo := range population {
+		genomes[chromo.GetPhenotype().(*binary.Bits).String()] = true
+	}
+
+	if len(genomes) < 2 {
+		t.Errorf("Expected the initial population to hold different genomes, Actual %v distinct", len(genomes))
+	}
+}
@@ -0,0 +1,205 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/chromosome/real"
+	"github.com/opticverge/goevolution/examples/onemax"
+	"github.com/opticverge/goevolution/examples/sphere"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/objective"
+	"github.com/opticverge/goevolution/solver"
+	"github.com/opticverge/goevolution/solver/cmaes"
+)
+
+// ellipsoidProblem is a sphere stretched by a factor of a million from the
+// first to the last dimension, which only solvers that learn the scale of
+// each dimension solve quickly
+type ellipsoidProblem struct {
+	real.Problem
+}
+
+func (p *ellipsoidProblem) ObjectiveFunction(chromo *chromosome.IChromosome) {
+	chromos := (*chromo).(*real.Chromosome)
+	n := float64(len(chromos.Phenotype) - 1)
+	fitness := 0.0
+	for i, val := range chromos.Phenotype {
+		fitness += math.Pow(1e6, float64(i)/n) * val * val
+	}
+	chromos.SetFitness(fitness)
+}
+
+func newEllipsoidProblem(dimensions int) *ellipsoidProblem {
+	p := &ellipsoidProblem{}
+	p.SetName("Ellipsoid")
+	p.SetObjective(objective.Minimisation)
+	p.SetBounds(real.NewUniformBounds(dimensions, -5, 5, real.Reflect))
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	return p
+}
+
+func TestCMAESConvergesOnSphere(t *testing.T) {
+
+	// GIVEN
+	p := sphere.NewProblem(10)
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+
+	s := cmaes.NewSolver(cmaes.NoRestart)
+	s.SetEpochs(400)
+	s.SetProblem(p)
+
+	// WHEN
+	best, err := s.RunContext(context.Background())
+
+	// THEN
+	if err != nil {
+		t.Fatalf("Expected no error, Actual %v", err)
+	}
+
+	if best.GetFitness() > 1e-10 {
+		t.Errorf("Expected the optimum of the sphere, Actual %v", best.GetFitness())
+	}
+
+	if lambda := s.(*cmaes.Solver).GetLambda(); lambda != 4+int(3*math.Log(10)) {
+		t.Errorf("Expected the default number of samples, Actual %v", lambda)
+	}
+
+	if s.GetPopulationSize() != 0 {
+		t.Errorf("Expected the population size to be left unset, Actual %v", s.GetPopulationSize())
+	}
+}
+
+func TestCMAESLearnsIllConditionedProblems(t *testing.T) {
+
+	// GIVEN
+	s := cmaes.NewSolver(cmaes.NoRestart)
+	s.SetEpochs(1500)
+	s.SetProblem(newEllipsoidProblem(8))
+
+	// WHEN
+	best := s.Run()
+
+	// THEN
+	if best.GetFitness() > 1e-8 {
+		t.Errorf("Expected the optimum of the ellipsoid, Actual %v", best.GetFitness())
+	}
+}
+
+func TestCMAESRestarts(t *testing.T) {
+
+	// GIVEN
+	for _, restart := range []cmaes.Restart{cmaes.IPOP, cmaes.BIPOP} {
+		p := sphere.NewProblem(4)
+		p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+
+		s := cmaes.NewSolver(restart)
+		s.SetEpochs(2000)
+		s.(*cmaes.Solver).SetMaxRestarts(3)
+		s.SetProblem(p)
+
+		// WHEN
+		best := s.Run()
+
+		// THEN
+		restarts := s.(*cmaes.Solver).GetRestarts()
+		if restarts == 0 {
+			t.Errorf("Expected %v to restart after converging, Actual %v restarts", restart, restarts)
+		}
+
+		if best.GetFitness() > 1e-10 {
+			t.Errorf("Expected %v to keep the optimum across restarts, Actual %v", restart, best.GetFitness())
+		}
+	}
+}
+
+func TestCMAESIsReproducible(t *testing.T) {
+
+	// GIVEN
+	seed := time.Now().UnixNano()
+	run := func() float64 {
+		p := sphere.NewProblem(5)
+		p.SetGenerator(generator.NewRandomGenerator(seed))
+		s := cmaes.NewSolver(cmaes.BIPOP)
+		s.SetEpochs(300)
+		s.SetProblem(p)
+		return s.Run().GetFitness()
+	}
+
+	// WHEN
+	first, second := run(), run()
+
+	// THEN
+	if first != second {
+		t.Errorf("Expected identical runs, Actual %v and %v", first, second)
+	}
+}
+
+func TestCMAESValidation(t *testing.T) {
+
+	// GIVEN
+	p := onemax.NewProblem()
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	p.SetDimensions(8)
+
+	s := cmaes.NewSolver(cmaes.Restart("Sometimes"))
+	s.SetProblem(p)
+
+	// WHEN
+	err := s.Validate()
+
+	// THEN
+	if !errors.Is(err, solver.ErrIncompatibleStrategy) {
+		t.Errorf("Expected errors for the restart strategy and the problem, Actual %v", err)
+	}
+}
+
+func TestCMAESRejectsUnboundedProblems(t *testing.T) {
+
+	// GIVEN
+	p := &ellipsoidProblem{}
+	p.SetName("Unbounded")
+	p.SetObjective(objective.Minimisation)
+	p.SetDimensions(4)
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+
+	s := cmaes.NewSolver(cmaes.NoRestart)
+	s.SetProblem(p)
+
+	// WHEN
+	err := s.Validate()
+
+	// THEN
+	var invalid *solver.ValidationError
+	if !errors.As(err, &invalid) || invalid.Field != "bounds" {
+		t.Errorf("Expected an error for the missing bounds, Actual %v", err)
+	}
+
+	if s.GetPopulationSize() != 0 {
+		t.Errorf("Expected validation to leave the population size unset, Actual %v", s.GetPopulationSize())
+	}
+}
+
+func TestCMAESValidationReportsEveryError(t *testing.T) {
+
+	// GIVEN
+	p := sphere.NewProblem(5)
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+
+	s := cmaes.NewSolver(cmaes.NoRestart)
+	s.SetProblem(p)
+	s.SetEpochs(-2)
+	s.(*cmaes.Solver).SetSigma(0)
+
+	// WHEN
+	err := s.Validate()
+
+	// THEN
+	if !errors.Is(err, solver.ErrInvalidEpochs) || !errors.Is(err, solver.ErrIncompatibleStrategy) {
+		t.Errorf("Expected errors for the epochs and the step size, Actual %v", err)
+	}
+}
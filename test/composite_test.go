@@ -0,0 +1,155 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/chromosome/binary"
+	"github.com/opticverge/goevolution/chromosome/composite"
+	"github.com/opticverge/goevolution/chromosome/integer"
+	"github.com/opticverge/goevolution/chromosome/permutation"
+	"github.com/opticverge/goevolution/chromosome/real"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/objective"
+	"github.com/opticverge/goevolution/solver"
+)
+
+// searchSpaceProblem mixes every kind of chromosome and minimises the sum of
+// the parts
+type searchSpaceProblem struct {
+	composite.Problem
+}
+
+func (p *searchSpaceProblem) ObjectiveFunction(chromo *chromosome.IChromosome) {
+	chromos := (*chromo).(*composite.Chromosome)
+	fitness := chromos.Get("rate").(*real.Chromosome).Phenotype[0]
+	fitness += float64(chromos.Get("layers").(*integer.Chromosome).Phenotype[0])
+	fitness += float64(chromos.Get("features").(*binary.Chromosome).Phenotype.Count())
+	fitness += float64(chromos.Get("order").(*permutation.Chromosome).Phenotype[0])
+	chromos.SetFitness(fitness)
+}
+
+func newSearchSpaceProblem() *searchSpaceProblem {
+	p := &searchSpaceProblem{}
+	p.SetName("Search Space")
+	p.SetObjective(objective.Minimisation)
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	p.AddPart(composite.NewPart("rate", func(rng generator.IGenerator) chromosome.IChromosome {
+		return real.NewChromosome(1, rng, real.NewUniformBounds(1, 0, 1, real.Clamp))
+	}))
+	p.AddPart(composite.NewPart("layers", func(rng generator.IGenerator) chromosome.IChromosome {
+		return integer.NewChromosome(1, rng, integer.NewUniformRanges(1, 1, 8))
+	}))
+	p.AddPart(composite.NewPart("features", func(rng generator.IGenerator) chromosome.IChromosome {
+		return binary.NewChromosome(20, rng)
+	}))
+	p.AddPart(composite.NewPart("order", func(rng generator.IGenerator) chromosome.IChromosome {
+		return permutation.NewChromosome(5, rng)
+	}))
+	return p
+}
+
+func TestCompositeStructuredPhenotype(t *testing.T) {
+
+	// GIVEN
+	p := newSearchSpaceProblem()
+	chr := p.GenerateChromosome()
+
+	// WHEN
+	chr.Generate()
+	phenotype := chr.GetPhenotype().(map[string]interface{})
+
+	// THEN
+	if len(phenotype) != 4 || chr.GetDimensions() != 4 {
+		t.Fatalf("Expected %v parts, Actual %v", 4, len(phenotype))
+	}
+
+	if _, ok := phenotype["layers"].([]int); !ok {
+		t.Errorf("Expected the layers to be a []int, Actual %T", phenotype["layers"])
+	}
+
+	if !permutation.Valid(phenotype["order"].([]int)) {
+		t.Errorf("Expected the order to be a permutation, Actual %v", phenotype["order"])
+	}
+}
+
+func TestCompositeProblemGeneratesDistinctChromosomes(t *testing.T) {
+
+	// GIVEN
+	p := newSearchSpaceProblem()
+	first := p.GenerateChromosome()
+	second := p.GenerateChromosome()
+
+	// WHEN
+	first.Generate()
+	second.Generate()
+
+	// THEN
+	if reflect.DeepEqual(first.GetPhenotype(), second.GetPhenotype()) {
+		t.Errorf("Expected every generated chromosome to have its own seed, Actual %v twice", first.GetPhenotype())
+	}
+}
+
+func TestCompositePartRates(t *testing.T) {
+
+	// GIVEN
+	p := newSearchSpaceProblem()
+	p.GetLayout()[2].MutationRate = 0
+	p.GetLayout()[2].CrossoverRate = 0
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	first := p.GenerateChromosome().(*composite.Chromosome)
+	second := p.GenerateChromosome().(*composite.Chromosome)
+	first.SetGenerator(generator.Derive(rng, 1))
+	second.SetGenerator(generator.Derive(rng, 2))
+	first.Generate()
+	second.Generate()
+	features := first.Get("features").GetPhenotype().(*binary.Bits).Clone()
+
+	if reflect.DeepEqual(features, second.Get("features").GetPhenotype()) {
+		t.Fatalf("Expected the parents to have different features, Actual %v", features)
+	}
+
+	// WHEN
+	first.Mutate(1)
+	children := first.Crossover(second, rng)
+
+	// THEN
+	if !reflect.DeepEqual(first.Get("features").GetPhenotype(), features) {
+		t.Errorf("Expected the part with no mutation rate to be unchanged")
+	}
+
+	if !reflect.DeepEqual(children[0].(*composite.Chromosome).Get("features").GetPhenotype(), features) ||
+		!reflect.DeepEqual(children[1].(*composite.Chromosome).Get("features").GetPhenotype(), second.Get("features").GetPhenotype()) {
+		t.Errorf("Expected the part with no crossover rate to be inherited from the parents")
+	}
+
+	if children[0].(*composite.Chromosome).Get("features") == first.Get("features") {
+		t.Errorf("Expected inherited parts to be copies")
+	}
+}
+
+func TestCompositeSolver(t *testing.T) {
+
+	// GIVEN
+	p := newSearchSpaceProblem()
+	s := solver.NewSolver()
+	s.SetEpochs(10)
+	s.SetProblem(p)
+	s.SetPopulationSize(20)
+
+	// WHEN
+	best := s.Run()
+
+	// THEN
+	if best == nil || s.GetError() != nil {
+		t.Fatalf("Expected a best chromosome, Actual error %v", s.GetError())
+	}
+
+	for _, c := range s.GetPopulation() {
+		if !permutation.Valid(c.(*composite.Chromosome).Get("order").GetPhenotype().([]int)) {
+			t.Errorf("Expected every order to remain a permutation")
+		}
+	}
+}
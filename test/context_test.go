@@ -0,0 +1,67 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/opticverge/goevolution/examples/onemax"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/solver"
+)
+
+func TestSolverRunContextDeadline(t *testing.T) {
+
+	// GIVEN
+	p := onemax.NewProblem()
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	p.SetDimensions(64)
+
+	s := solver.NewSolver()
+	s.SetEpochs(-1)
+	s.SetProblem(p)
+	s.SetPopulationSize(10)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// WHEN
+	bestChromosome, err := s.RunContext(ctx)
+
+	// THEN
+	if err != context.DeadlineExceeded {
+		t.Errorf("Expected error to be %v, Actual %v", context.DeadlineExceeded, err)
+	}
+
+	if bestChromosome == nil {
+		t.Errorf("Expected the best chromosome so far to be returned")
+	}
+}
+
+func TestSolverRunContextCancelled(t *testing.T) {
+
+	// GIVEN
+	p := onemax.NewProblem()
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	p.SetDimensions(8)
+
+	s := solver.NewSolver()
+	s.SetEpochs(-1)
+	s.SetProblem(p)
+	s.SetPopulationSize(10)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// WHEN
+	_, err := s.RunContext(ctx)
+
+	// THEN
+	if err != context.Canceled {
+		t.Errorf("Expected error to be %v, Actual %v", context.Canceled, err)
+	}
+
+	if s.GetEvaluations() != 0 {
+		t.Errorf("Expected no evaluations after cancellation, Actual %v", s.GetEvaluations())
+	}
+}
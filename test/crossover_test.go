@@ -0,0 +1,52 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/chromosome/binary"
+	"github.com/opticverge/goevolution/examples/onemax"
+	"github.com/opticverge/goevolution/generator"
+)
+
+func TestOneMaxCrossoverPreservesGenes(t *testing.T) {
+
+	// GIVEN
+	dimensions := 16
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	first := onemax.NewChromosome(dimensions, rng)
+	second := onemax.NewChromosome(dimensions, rng)
+	first.Generate()
+	second.Generate()
+
+	// WHEN
+	children := first.(chromosome.ICrossover).Crossover(second, rng)
+
+	// THEN
+	if len(children) != 2 {
+		t.Fatalf("Expected crossover to produce %v children, Actual %v", 2, len(children))
+	}
+
+	parents := first.(*binary.Chromosome).Phenotype
+	mates := second.(*binary.Chromosome).Phenotype
+	left := children[0].(*binary.Chromosome).Phenotype
+	right := children[1].(*binary.Chromosome).Phenotype
+
+	for i := 0; i < dimensions; i++ {
+		if ones(left.Get(i), right.Get(i)) != ones(parents.Get(i), mates.Get(i)) {
+			t.Errorf("Expected genes at %v to be inherited from the parents", i)
+		}
+	}
+}
+
+// ones returns the number of the bits which are set
+func ones(bits ...bool) int {
+	count := 0
+	for _, bit := range bits {
+		if bit {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,165 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/chromosome/real"
+	"github.com/opticverge/goevolution/examples/onemax"
+	"github.com/opticverge/goevolution/examples/sphere"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/objective"
+	"github.com/opticverge/goevolution/solver"
+	"github.com/opticverge/goevolution/solver/de"
+)
+
+// fragileProblem is a sphere whose evaluation fails unless the first gene
+// lies near the lower bound, so that most chromosomes are discarded
+type fragileProblem struct {
+	real.Problem
+}
+
+func (p *fragileProblem) ObjectiveFunction(chromo *chromosome.IChromosome) {
+	x := (*chromo).(*real.Chromosome).Phenotype
+	if x[0] > -0.9 {
+		panic("fragile")
+	}
+	fitness := 0.0
+	for _, val := range x {
+		fitness += val * val
+	}
+	(*chromo).SetFitness(fitness)
+}
+
+func TestDifferentialEvolutionStrategies(t *testing.T) {
+
+	// GIVEN
+	cases := []struct {
+		strategy de.Strategy
+		control  de.IParameterControl
+	}{
+		{de.Rand1Bin, de.NewConstantControl(0.5, 0.9)},
+		{de.Best1Bin, de.NewConstantControl(0.5, 0.9)},
+		{de.CurrentToBest1Bin, de.NewConstantControl(0.5, 0.9)},
+		{de.Rand2Bin, de.NewConstantControl(0.5, 0.9)},
+		{de.CurrentToPBest1Bin, de.NewJADEControl(0.1)},
+		{de.CurrentToPBest1Bin, de.NewSHADEControl(30)},
+	}
+
+	for _, c := range cases {
+		p := sphere.NewProblem(5)
+		p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+
+		s := de.NewSolver(c.strategy, c.control)
+		s.SetEpochs(150)
+		s.SetProblem(p)
+		s.SetPopulationSize(30)
+
+		// WHEN
+		best, err := s.RunContext(context.Background())
+
+		// THEN
+		if err != nil {
+			t.Fatalf("Expected no error for %v, Actual %v", c.strategy, err)
+		}
+
+		if best.GetFitness() > 1e-3 {
+			t.Errorf("Expected %v with %T to approach the optimum, Actual %v", c.strategy, c.control, best.GetFitness())
+		}
+	}
+}
+
+func TestDifferentialEvolutionIsReproducible(t *testing.T) {
+
+	// GIVEN
+	seed := time.Now().UnixNano()
+	run := func() float64 {
+		p := sphere.NewProblem(5)
+		p.SetGenerator(generator.NewRandomGenerator(seed))
+		s := de.NewSolver(de.CurrentToPBest1Bin, de.NewSHADEControl(20))
+		s.SetEpochs(20)
+		s.SetProblem(p)
+		s.SetPopulationSize(20)
+		return s.Run().GetFitness()
+	}
+
+	// WHEN
+	first, second := run(), run()
+
+	// THEN
+	if first != second {
+		t.Errorf("Expected identical runs, Actual %v and %v", first, second)
+	}
+}
+
+func TestDifferentialEvolutionValidation(t *testing.T) {
+
+	// GIVEN
+	p := onemax.NewProblem()
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	p.SetDimensions(8)
+
+	s := de.NewSolver(de.Rand2Bin, de.NewConstantControl(0.5, 0.9))
+	s.SetProblem(p)
+	s.SetPopulationSize(5)
+
+	// WHEN
+	err := s.Validate()
+
+	// THEN
+	if !errors.Is(err, solver.ErrInvalidPopulationSize) || !errors.Is(err, solver.ErrIncompatibleStrategy) {
+		t.Errorf("Expected errors for the population size and the problem, Actual %v", err)
+	}
+}
+
+func TestDifferentialEvolutionValidationReportsEveryError(t *testing.T) {
+
+	// GIVEN
+	p := sphere.NewProblem(5)
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+
+	s := de.NewSolver(de.Rand1Bin, nil)
+	s.SetProblem(p)
+	s.SetPopulationSize(10)
+	s.SetEpochs(-2)
+
+	// WHEN
+	err := s.Validate()
+
+	// THEN
+	if !errors.Is(err, solver.ErrInvalidEpochs) || !errors.Is(err, solver.ErrIncompatibleStrategy) {
+		t.Errorf("Expected errors for the epochs and the parameter control, Actual %v", err)
+	}
+}
+
+func TestDifferentialEvolutionStopsWhenDiscardsShrinkThePopulation(t *testing.T) {
+
+	// GIVEN
+	p := &fragileProblem{}
+	p.SetName("Fragile")
+	p.SetObjective(objective.Minimisation)
+	p.SetBounds(real.NewUniformBounds(3, -1, 1, real.Clamp))
+	p.SetGenerator(generator.NewRandomGenerator(42))
+
+	s := de.NewSolver(de.Rand1Bin, de.NewConstantControl(0.5, 0.9))
+	s.SetEpochs(10)
+	s.SetProblem(p)
+	s.SetPopulationSize(10)
+	s.SetEvaluationPolicy(solver.Discard)
+
+	// WHEN
+	_, err := s.RunContext(context.Background())
+
+	// THEN
+	if err != nil {
+		t.Fatalf("Expected no error, Actual %v", err)
+	}
+
+	if reason := s.GetTerminationReason(); !strings.Contains(reason, "needs at least 4 chromosomes") {
+		t.Errorf("Expected the run to stop for the lack of chromosomes, Actual %q", reason)
+	}
+}
@@ -0,0 +1,143 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/chromosome/binary"
+	"github.com/opticverge/goevolution/examples/onemax"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/problem"
+	"github.com/opticverge/goevolution/solver"
+)
+
+var errUnlucky = errors.New("unlucky chromosome")
+
+// failingProblem fails to evaluate every chromosome whose first gene is set
+type failingProblem struct {
+	onemax.Problem
+}
+
+func (p *failingProblem) Evaluate(ctx context.Context, chromo chromosome.IChromosome) (float64, error) {
+	phenotype := chromo.(*binary.Chromosome).Phenotype
+	if phenotype.Get(0) {
+		return 0, errUnlucky
+	}
+	return float64(phenotype.Count()), nil
+}
+
+// panickingProblem panics when evaluating any chromosome
+type panickingProblem struct {
+	onemax.Problem
+}
+
+func (p *panickingProblem) ObjectiveFunction(chromo *chromosome.IChromosome) {
+	panic("objective function exploded")
+}
+
+func newFailingSolver(p problem.IProblem, policy solver.EvaluationPolicy) solver.ISolver {
+	p.SetName("Failing")
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	p.SetDimensions(8)
+
+	s := solver.NewSolver()
+	s.SetEpochs(3)
+	s.SetProblem(p)
+	s.SetPopulationSize(20)
+	s.SetEvaluationPolicy(policy)
+	return s
+}
+
+func newFailingProblem() *failingProblem {
+	p := &failingProblem{}
+	p.SetObjective(onemax.NewProblem().GetObjective())
+	return p
+}
+
+func TestAbortPolicySurfacesError(t *testing.T) {
+
+	// GIVEN
+	s := newFailingSolver(newFailingProblem(), solver.Abort)
+
+	// WHEN
+	_, err := s.RunContext(context.Background())
+
+	// THEN
+	if !errors.Is(err, errUnlucky) {
+		t.Errorf("Expected error to wrap %v, Actual %v", errUnlucky, err)
+	}
+}
+
+func TestZeroPolicyAbortsAndRunReportsError(t *testing.T) {
+
+	// GIVEN
+	s := newFailingSolver(newFailingProblem(), "")
+
+	// WHEN
+	s.Run()
+
+	// THEN
+	if !errors.Is(s.GetError(), errUnlucky) {
+		t.Errorf("Expected GetError to wrap %v after Run, Actual %v", errUnlucky, s.GetError())
+	}
+}
+
+func TestAssignWorstPolicy(t *testing.T) {
+
+	// GIVEN
+	s := newFailingSolver(newFailingProblem(), solver.AssignWorst)
+
+	// WHEN
+	_, err := s.RunContext(context.Background())
+
+	// THEN
+	if err != nil {
+		t.Fatalf("Expected no error, Actual %v", err)
+	}
+
+	for _, c := range s.GetPopulation() {
+		if c.(*binary.Chromosome).Phenotype.Get(0) && !math.IsInf(c.GetFitness(), -1) {
+			t.Errorf("Expected failed chromosome to have the worst fitness, Actual %v", c.GetFitness())
+		}
+	}
+}
+
+func TestDiscardPolicy(t *testing.T) {
+
+	// GIVEN
+	s := newFailingSolver(newFailingProblem(), solver.Discard)
+
+	// WHEN
+	_, err := s.RunContext(context.Background())
+
+	// THEN
+	if err != nil {
+		t.Fatalf("Expected no error, Actual %v", err)
+	}
+
+	for _, c := range s.GetPopulation() {
+		if c.(*binary.Chromosome).Phenotype.Get(0) {
+			t.Errorf("Expected failed chromosomes to be discarded")
+		}
+	}
+}
+
+func TestPanicIsRecovered(t *testing.T) {
+
+	// GIVEN
+	p := &panickingProblem{}
+	s := newFailingSolver(p, solver.Abort)
+
+	// WHEN
+	_, err := s.RunContext(context.Background())
+
+	// THEN
+	var panicError *problem.PanicError
+	if !errors.As(err, &panicError) {
+		t.Errorf("Expected a PanicError, Actual %v", err)
+	}
+}
@@ -0,0 +1,251 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/examples/symbolicregression"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/gp"
+	"github.com/opticverge/goevolution/objective"
+	"github.com/opticverge/goevolution/solver"
+)
+
+const boolean gp.Type = "Boolean"
+
+// newTypedSet creates a primitive set where a number is chosen by a boolean
+// condition, so that trees mix two types
+func newTypedSet() *gp.PrimitiveSet {
+	set := symbolicregression.NewArithmeticSet()
+	set.AddFunction("if", symbolicregression.Number, []gp.Type{boolean, symbolicregression.Number, symbolicregression.Number}, func(args ...interface{}) interface{} {
+		if args[0].(bool) {
+			return args[1]
+		}
+		return args[2]
+	})
+	set.AddFunction("<", boolean, []gp.Type{symbolicregression.Number, symbolicregression.Number}, func(args ...interface{}) interface{} {
+		return args[0].(float64) < args[1].(float64)
+	})
+	set.AddConstant("true", boolean, true)
+	return set
+}
+
+// wellTyped reports whether every child returns the type its function accepts
+func wellTyped(node *gp.Node) bool {
+	for i, child := range node.Children {
+		if child.Type() != node.Function.Arguments[i] || !wellTyped(child) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestTreeEvaluation(t *testing.T) {
+
+	// GIVEN
+	set := newTypedSet()
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	tree := gp.Full(set, symbolicregression.Number, 0, rng)
+	for tree.Terminal.Name != "x" {
+		tree = gp.Full(set, symbolicregression.Number, 0, rng)
+	}
+	add := set.GetFunctions(symbolicregression.Number)[0]
+	sum := &gp.Node{Function: add, Children: []*gp.Node{tree, tree.Clone()}}
+
+	// WHEN
+	value := sum.Evaluate(gp.Environment{"x": 1.5})
+
+	// THEN
+	if value.(float64) != 3 || sum.String() != "(+ x x)" {
+		t.Errorf("Expected (+ x x) to be %v, Actual %v is %v", 3, sum, value)
+	}
+
+	if sum.Depth() != 1 || sum.Size() != 3 {
+		t.Errorf("Expected depth and size to be 1 and 3, Actual %v and %v", sum.Depth(), sum.Size())
+	}
+}
+
+func TestInitialisationDepths(t *testing.T) {
+
+	// GIVEN
+	set := newTypedSet()
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+
+	for depth := 0; depth < 5; depth++ {
+
+		// WHEN
+		full := gp.Full(set, set.GetRoot(), depth, rng)
+		grown := gp.Grow(set, set.GetRoot(), depth, rng)
+
+		// THEN
+		if full.Depth() != depth {
+			t.Errorf("Expected full tree to have depth %v, Actual %v", depth, full.Depth())
+		}
+
+		if grown.Depth() > depth {
+			t.Errorf("Expected grown tree to have at most depth %v, Actual %v", depth, grown.Depth())
+		}
+
+		if !wellTyped(full) || !wellTyped(grown) {
+			t.Errorf("Expected trees to be well typed, Actual %v and %v", full, grown)
+		}
+	}
+}
+
+func TestVariationKeepsTypesAndLimits(t *testing.T) {
+
+	// GIVEN
+	set := newTypedSet()
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	mutations := []gp.IMutation{
+		gp.NewSubtreeMutation(3),
+		gp.NewPointMutation(),
+		gp.NewHoistMutation(),
+	}
+	limits := gp.NewLimits(6, 200)
+
+	for _, m := range mutations {
+		chr := gp.NewChromosome(rng, set).(*gp.Chromosome)
+		chr.SetLimits(limits)
+		chr.SetInitialisation(gp.NewRampedHalfAndHalf(2, 4))
+		chr.SetMutation(m)
+		chr.Generate()
+		mate := chr.Clone(rng).(*gp.Chromosome)
+		mate.Generate()
+
+		for trial := 0; trial < 50; trial++ {
+
+			// WHEN
+			chr.Mutate(0.5)
+			children := chr.Crossover(mate, rng)
+
+			// THEN
+			for _, child := range append(children, chr) {
+				tree := child.(*gp.Chromosome).Phenotype
+				if !wellTyped(tree) || tree.Type() != set.GetRoot() {
+					t.Fatalf("Expected %T to keep the tree well typed, Actual %v", m, tree)
+				}
+				if !limits.Allows(tree) {
+					t.Fatalf("Expected %T to keep the tree within the limits, Actual %v", m, tree)
+				}
+			}
+		}
+	}
+}
+
+func TestHoistMutationShrinks(t *testing.T) {
+
+	// GIVEN
+	set := newTypedSet()
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	tree := gp.Full(set, set.GetRoot(), 4, rng)
+
+	// WHEN
+	hoisted := gp.NewHoistMutation().Mutate(tree, 1, set, rng)
+
+	// THEN
+	if hoisted.Size() > tree.Size() {
+		t.Errorf("Expected hoisted tree to be no larger than %v, Actual %v", tree.Size(), hoisted.Size())
+	}
+}
+
+func TestPrimitiveSetValidation(t *testing.T) {
+
+	// GIVEN
+	set := gp.NewPrimitiveSet(symbolicregression.Number)
+	set.AddVariable("x", symbolicregression.Number)
+	set.AddFunction("<", symbolicregression.Number, []gp.Type{boolean}, func(args ...interface{}) interface{} {
+		return 0.0
+	})
+
+	// WHEN
+	err := set.Validate()
+
+	// THEN
+	if err == nil {
+		t.Errorf("Expected an error for the type without terminals")
+	}
+
+	if err := newTypedSet().Validate(); err != nil {
+		t.Errorf("Expected no error, Actual %v", err)
+	}
+}
+
+func TestLexicographicParsimony(t *testing.T) {
+
+	// GIVEN
+	set := newTypedSet()
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	small := gp.NewChromosome(rng, set).(*gp.Chromosome)
+	small.Phenotype = gp.Full(set, set.GetRoot(), 1, rng)
+	large := gp.NewChromosome(rng, set).(*gp.Chromosome)
+	large.Phenotype = gp.Full(set, set.GetRoot(), 3, rng)
+	small.SetFitness(1)
+	large.SetFitness(1)
+
+	// WHEN
+	selected := gp.NewLexicographicTournamentSelector(10).Select([]chromosome.IChromosome{large, small}, 20, objective.Minimisation, rng)
+
+	// THEN
+	wins := 0
+	for _, c := range selected {
+		if c == small {
+			wins++
+		}
+	}
+
+	// a tournament only misses the smaller tree when all 10 draws pick the
+	// larger one
+	if wins < 18 {
+		t.Errorf("Expected the smaller tree of equal fitness to win nearly every tournament, Actual %v of 20", wins)
+	}
+}
+
+func TestSizeLimitWithoutDepthLimitRuns(t *testing.T) {
+
+	// GIVEN
+	p := symbolicregression.NewProblem().(*symbolicregression.Problem)
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	p.SetLimits(gp.NewLimits(0, 200))
+
+	s := solver.NewSolver()
+	s.SetEpochs(3)
+	s.SetProblem(p)
+	s.SetPopulationSize(20)
+
+	// WHEN
+	best, err := s.RunContext(context.Background())
+
+	// THEN
+	if err != nil {
+		t.Fatalf("Expected no error, Actual %v", err)
+	}
+
+	if p.GetDimensions() != 200 {
+		t.Errorf("Expected the size limit as the dimensions, Actual %v", p.GetDimensions())
+	}
+
+	if tree := best.GetPhenotype().(*gp.Node); best.GetDimensions() != tree.Size() {
+		t.Errorf("Expected the tree size %v as the chromosome dimensions, Actual %v", tree.Size(), best.GetDimensions())
+	}
+}
+
+func TestGPProblemGeneratesDistinctChromosomes(t *testing.T) {
+
+	// GIVEN
+	p := symbolicregression.NewProblem()
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	first := p.GenerateChromosome()
+	second := p.GenerateChromosome()
+
+	// WHEN
+	first.Generate()
+	second.Generate()
+
+	// THEN
+	if tree := first.GetPhenotype().(*gp.Node).String(); tree == second.GetPhenotype().(*gp.Node).String() {
+		t.Errorf("Expected every generated chromosome to have its own seed, Actual %v twice", tree)
+	}
+}
@@ -0,0 +1,111 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/opticverge/goevolution/chromosome/integer"
+	"github.com/opticverge/goevolution/generator"
+)
+
+func TestIntegerRangesSampleInclusive(t *testing.T) {
+
+	// GIVEN
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	ranges := integer.NewRanges([]int{0, 5}, []int{2, 5})
+	seen := map[int]bool{}
+
+	// WHEN
+	for i := 0; i < 200; i++ {
+		seen[ranges.Sample(0, rng)] = true
+		if value := ranges.Sample(1, rng); value != 5 {
+			t.Fatalf("Expected fixed gene to be %v, Actual %v", 5, value)
+		}
+	}
+
+	// THEN
+	if len(seen) != 3 || !seen[0] || !seen[1] || !seen[2] {
+		t.Errorf("Expected every value from 0 to 2 to be sampled, Actual %v", seen)
+	}
+}
+
+func TestIntegerMutationsStayWithinRanges(t *testing.T) {
+
+	// GIVEN
+	dimensions := 10
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	ranges := integer.NewUniformRanges(dimensions, -3, 3)
+	mutations := []integer.IMutation{
+		integer.NewRandomResetMutation(),
+		integer.NewCreepMutation(2),
+		integer.NewGaussianMutation(0.5),
+	}
+
+	for _, mutation := range mutations {
+		p := &integer.Problem{}
+		p.SetGenerator(rng)
+		p.SetRanges(ranges)
+		p.SetMutation(mutation)
+		chr := p.GenerateChromosome().(*integer.Chromosome)
+		chr.Generate()
+
+		for trial := 0; trial < 50; trial++ {
+
+			// WHEN
+			chr.Mutate(0.5)
+
+			// THEN
+			for i, value := range chr.Phenotype {
+				if !ranges.Contains(i, value) {
+					t.Fatalf("Expected %T to keep gene %v within range, Actual %v", mutation, i, value)
+				}
+			}
+		}
+	}
+}
+
+func TestKPointCrossoverSections(t *testing.T) {
+
+	// GIVEN
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	first := []int{0, 0, 0, 0, 0, 0, 0, 0}
+	second := []int{1, 1, 1, 1, 1, 1, 1, 1}
+
+	// WHEN
+	children := integer.NewKPointCrossover(3).Crossover(first, second, rng)
+
+	// THEN
+	changes := 0
+	for i := 1; i < len(first); i++ {
+		if children[0][i] != children[0][i-1] {
+			changes++
+		}
+		if children[0][i]+children[1][i] != 1 {
+			t.Errorf("Expected gene at %v to be inherited from the parents", i)
+		}
+	}
+
+	if changes != 3 || children[0][0] != 0 {
+		t.Errorf("Expected %v cuts starting from the first parent, Actual %v", 3, children[0])
+	}
+}
+
+func TestIntegerProblemGeneratesDistinctChromosomes(t *testing.T) {
+
+	// GIVEN
+	p := &integer.Problem{}
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	p.SetRanges(integer.NewUniformRanges(10, 0, 100))
+	first := p.GenerateChromosome()
+	second := p.GenerateChromosome()
+
+	// WHEN
+	first.Generate()
+	second.Generate()
+
+	// THEN
+	if reflect.DeepEqual(first.GetPhenotype(), second.GetPhenotype()) {
+		t.Errorf("Expected every generated chromosome to have its own seed, Actual %v twice", first.GetPhenotype())
+	}
+}
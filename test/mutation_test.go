@@ -0,0 +1,67 @@
+package test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/opticverge/goevolution/mutation"
+)
+
+func TestRankExponentialStrategyVariesWithRank(t *testing.T) {
+
+	// GIVEN
+	strategy := mutation.NewRankExponentialStrategy(2.4, 0)
+	best := mutation.State{Rank: 0, PopulationSize: 10}
+	worst := mutation.State{Rank: 9, PopulationSize: 10}
+
+	// WHEN
+	bestProbability := strategy.MutationProbability(best)
+	worstProbability := strategy.MutationProbability(worst)
+
+	// THEN
+	if math.Abs(bestProbability-math.Exp(-2.4)) > 1e-9 {
+		t.Errorf("Expected best probability to be %v, Actual %v", math.Exp(-2.4), bestProbability)
+	}
+
+	if worstProbability <= bestProbability {
+		t.Errorf("Expected worst probability %v to exceed best probability %v", worstProbability, bestProbability)
+	}
+
+	if strategy.CloneCount(best) != 10 {
+		t.Errorf("Expected clone count to default to the population size, Actual %v", strategy.CloneCount(best))
+	}
+}
+
+func TestLinearDecayStrategyDecays(t *testing.T) {
+
+	// GIVEN
+	strategy := mutation.NewLinearDecayStrategy(0.5, 0.1, 0, 4)
+
+	// WHEN
+	start := strategy.MutationProbability(mutation.State{Generation: 0, Epochs: 10})
+	middle := strategy.MutationProbability(mutation.State{Generation: 5, Epochs: 10})
+	end := strategy.MutationProbability(mutation.State{Generation: 20, Epochs: 10})
+
+	// THEN
+	if start != 0.5 || math.Abs(middle-0.3) > 1e-9 || math.Abs(end-0.1) > 1e-9 {
+		t.Errorf("Expected probabilities 0.5, 0.3 and 0.1, Actual %v, %v and %v", start, middle, end)
+	}
+}
+
+func TestSelfAdaptiveStrategyIncreasesOnSuccess(t *testing.T) {
+
+	// GIVEN
+	strategy := mutation.NewSelfAdaptiveStrategy(0.1, 1).(mutation.IAdaptiveMutationStrategy)
+	initial := strategy.MutationProbability(mutation.State{Generation: 1})
+	for i := 0; i < 10; i++ {
+		strategy.Feedback(mutation.State{Generation: 1}, true)
+	}
+
+	// WHEN
+	adapted := strategy.MutationProbability(mutation.State{Generation: 2})
+
+	// THEN
+	if adapted <= initial {
+		t.Errorf("Expected probability to increase from %v, Actual %v", initial, adapted)
+	}
+}
@@ -0,0 +1,477 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/chromosome/real"
+	"github.com/opticverge/goevolution/examples/sphere"
+	"github.com/opticverge/goevolution/examples/zdt1"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/objective"
+	"github.com/opticverge/goevolution/problem"
+	"github.com/opticverge/goevolution/solver"
+	"github.com/opticverge/goevolution/solver/nsga2"
+)
+
+// failingZDT1Problem is the ZDT1 problem whose evaluation fails whenever the
+// first gene lies above a half
+type failingZDT1Problem struct {
+	zdt1.Problem
+}
+
+func (p *failingZDT1Problem) ObjectiveFunction(chromo *chromosome.IChromosome) {
+	if (*chromo).(*real.Chromosome).Phenotype[0] > 0.5 {
+		panic("unlucky")
+	}
+	p.Problem.ObjectiveFunction(chromo)
+}
+
+// childlessChromosome is a real valued chromosome whose crossover returns no
+// children
+type childlessChromosome struct {
+	*real.Chromosome
+}
+
+func (c *childlessChromosome) Clone(rng generator.IGenerator) chromosome.IChromosome {
+	return &childlessChromosome{c.Chromosome.Clone(rng).(*real.Chromosome)}
+}
+
+func (c *childlessChromosome) Crossover(other chromosome.IChromosome, rng generator.IGenerator) []chromosome.IChromosome {
+	return nil
+}
+
+// childlessProblem trades the first gene against its complement over
+// chromosomes whose crossover returns no children
+type childlessProblem struct {
+	zdt1.Problem
+}
+
+func (p *childlessProblem) GenerateChromosome() chromosome.IChromosome {
+	return &childlessChromosome{p.Problem.GenerateChromosome().(*real.Chromosome)}
+}
+
+func (p *childlessProblem) ObjectiveFunction(chromo *chromosome.IChromosome) {
+	x := (*chromo).GetPhenotype().([]float64)
+	(*chromo).SetFitnesses([]float64{x[0], 1 - x[0]})
+}
+
+// recordingChromosome is a real valued chromosome which records the seed of
+// every crossover it takes part in
+type recordingChromosome struct {
+	*real.Chromosome
+	seeds *[]int64
+}
+
+func (c *recordingChromosome) Clone(rng generator.IGenerator) chromosome.IChromosome {
+	return &recordingChromosome{c.Chromosome.Clone(rng).(*real.Chromosome), c.seeds}
+}
+
+func (c *recordingChromosome) Crossover(other chromosome.IChromosome, rng generator.IGenerator) []chromosome.IChromosome {
+	*c.seeds = append(*c.seeds, rng.GetSeed())
+	children := c.Chromosome.Crossover(other.(*recordingChromosome).Chromosome, rng)
+	for i, child := range children {
+		children[i] = &recordingChromosome{child.(*real.Chromosome), c.seeds}
+	}
+	return children
+}
+
+// recordingProblem is the ZDT1 problem over chromosomes which record the
+// seeds of their crossovers
+type recordingProblem struct {
+	zdt1.Problem
+	seeds []int64
+}
+
+func (p *recordingProblem) GenerateChromosome() chromosome.IChromosome {
+	return &recordingChromosome{p.Problem.GenerateChromosome().(*real.Chromosome), &p.seeds}
+}
+
+func (p *recordingProblem) ObjectiveFunction(chromo *chromosome.IChromosome) {
+	var unwrapped chromosome.IChromosome = (*chromo).(*recordingChromosome).Chromosome
+	p.Problem.ObjectiveFunction(&unwrapped)
+}
+
+var errInfeasible = errors.New("infeasible design")
+
+// fallibleZDT1Problem is the ZDT1 problem evaluated through a fallible
+// evaluator, which rejects every chromosome whose first gene lies above a
+// half
+type fallibleZDT1Problem struct {
+	zdt1.Problem
+}
+
+func (p *fallibleZDT1Problem) EvaluateObjectives(ctx context.Context, chromo chromosome.IChromosome) ([]float64, error) {
+	if chromo.(*real.Chromosome).Phenotype[0] > 0.5 {
+		return nil, errInfeasible
+	}
+	p.Problem.ObjectiveFunction(&chromo)
+	return chromo.GetFitnesses(), nil
+}
+
+func newObjectivePopulation(fitnesses ...[]float64) []chromosome.IChromosome {
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	population := make([]chromosome.IChromosome, len(fitnesses))
+	for i, f := range fitnesses {
+		chromo := real.NewChromosome(1, rng, real.NewUniformBounds(1, 0, 1, real.Clamp))
+		chromo.SetFitnesses(f)
+		population[i] = chromo
+	}
+	return population
+}
+
+func TestDominatesRespectsDirections(t *testing.T) {
+
+	// GIVEN
+	objectives := []objective.Objective{objective.Minimisation, objective.Maximisation}
+
+	// WHEN
+	dominates := objective.Dominates([]float64{1, 5}, []float64{2, 5}, objectives)
+	equal := objective.Dominates([]float64{1, 5}, []float64{1, 5}, objectives)
+	traded := objective.Dominates([]float64{1, 4}, []float64{2, 5}, objectives)
+
+	// THEN
+	if !dominates || equal || traded {
+		t.Errorf("Expected only the first pair to dominate, Actual %v, %v and %v", dominates, equal, traded)
+	}
+}
+
+func TestDominatesIgnoresMissingObjectives(t *testing.T) {
+
+	// GIVEN
+	objectives := []objective.Objective{objective.Minimisation, objective.Minimisation}
+
+	// WHEN
+	dominates := objective.Dominates([]float64{1}, []float64{2, 2}, objectives)
+	dominated := objective.Dominates([]float64{1, 1}, []float64{2}, objectives)
+
+	// THEN
+	if dominates || dominated {
+		t.Errorf("Expected fitnesses missing an objective to neither dominate nor be dominated, Actual %v and %v", dominates, dominated)
+	}
+}
+
+func TestChromosomeFitnessesDefaultToFitness(t *testing.T) {
+
+	// GIVEN
+	population := newObjectivePopulation([]float64{3, 4})
+	single := real.NewChromosome(1, generator.NewRandomGenerator(1), real.NewUniformBounds(1, 0, 1, real.Clamp))
+	single.SetFitness(7)
+
+	// WHEN
+	first := population[0].GetFitness()
+	fitnesses := single.GetFitnesses()
+
+	// THEN
+	if first != 3 {
+		t.Errorf("Expected the fitness to follow the first objective, Actual %v", first)
+	}
+
+	if len(fitnesses) != 1 || fitnesses[0] != 7 {
+		t.Errorf("Expected the fitnesses of a single objective to be [7], Actual %v", fitnesses)
+	}
+}
+
+func TestSetFitnessReplacesFitnesses(t *testing.T) {
+
+	// GIVEN
+	chromo := newObjectivePopulation([]float64{3, 4})[0]
+
+	// WHEN
+	chromo.SetFitness(math.Inf(1))
+
+	// THEN
+	if f := chromo.GetFitnesses(); len(f) != 1 || !math.IsInf(f[0], 1) {
+		t.Errorf("Expected the fitnesses to follow the new fitness, Actual %v", f)
+	}
+}
+
+func TestNonDominatedSortFronts(t *testing.T) {
+
+	// GIVEN
+	population := newObjectivePopulation(
+		[]float64{1, 4},
+		[]float64{2, 2},
+		[]float64{4, 1},
+		[]float64{3, 3},
+		[]float64{5, 5},
+	)
+	objectives := []objective.Objective{objective.Minimisation, objective.Minimisation}
+
+	// WHEN
+	fronts := nsga2.NonDominatedSort(population, objectives)
+
+	// THEN
+	if len(fronts) != 3 || len(fronts[0]) != 3 || len(fronts[1]) != 1 || len(fronts[2]) != 1 {
+		t.Fatalf("Expected fronts of 3, 1 and 1 chromosomes, Actual %v fronts", len(fronts))
+	}
+
+	if fronts[1][0] != population[3] || fronts[2][0] != population[4] {
+		t.Errorf("Expected [3 3] then [5 5] behind the Pareto front")
+	}
+}
+
+func TestCrowdingDistancesKeepExtremes(t *testing.T) {
+
+	// GIVEN
+	front := newObjectivePopulation(
+		[]float64{0, 4},
+		[]float64{1, 3},
+		[]float64{3, 1},
+		[]float64{4, 0},
+	)
+
+	// WHEN
+	distances := nsga2.CrowdingDistances(front)
+
+	// THEN
+	if !math.IsInf(distances[0], 1) || !math.IsInf(distances[3], 1) {
+		t.Errorf("Expected the extremes to have infinite distance, Actual %v", distances)
+	}
+
+	if math.Abs(distances[1]-1.5) > 1e-9 || math.Abs(distances[2]-1.5) > 1e-9 {
+		t.Errorf("Expected the inner distances to be 1.5, Actual %v", distances)
+	}
+}
+
+func TestNSGA2ApproachesParetoFront(t *testing.T) {
+
+	// GIVEN
+	p := zdt1.NewProblem(10)
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+
+	s := nsga2.NewSolver()
+	s.SetEpochs(150)
+	s.SetProblem(p)
+	s.SetPopulationSize(60)
+
+	// WHEN
+	_, err := s.RunContext(context.Background())
+	front := s.(*nsga2.Solver).GetFront()
+
+	// THEN
+	if err != nil {
+		t.Fatalf("Expected no error, Actual %v", err)
+	}
+
+	if len(front) < 30 {
+		t.Errorf("Expected most of the population on the Pareto front, Actual %v", len(front))
+	}
+
+	low, high := 1.0, 0.0
+	for _, chromo := range front {
+		f := chromo.GetFitnesses()
+		if gap := f[1] - (1 - math.Sqrt(f[0])); gap > 0.1 {
+			t.Errorf("Expected %v to lie near the Pareto front, Actual gap %v", f, gap)
+		}
+		low, high = math.Min(low, f[0]), math.Max(high, f[0])
+	}
+
+	if high-low < 0.8 {
+		t.Errorf("Expected the front to spread across the first objective, Actual [%v, %v]", low, high)
+	}
+}
+
+func TestNSGA2IsReproducible(t *testing.T) {
+
+	// GIVEN
+	seed := time.Now().UnixNano()
+	run := func() []float64 {
+		p := zdt1.NewProblem(5)
+		p.SetGenerator(generator.NewRandomGenerator(seed))
+		s := nsga2.NewSolver()
+		s.SetEpochs(20)
+		s.SetProblem(p)
+		s.SetPopulationSize(20)
+		s.Run()
+
+		var fitnesses []float64
+		for _, chromo := range s.(*nsga2.Solver).GetFront() {
+			fitnesses = append(fitnesses, chromo.GetFitnesses()...)
+		}
+		return fitnesses
+	}
+
+	// WHEN
+	first, second := run(), run()
+
+	// THEN
+	if len(first) != len(second) {
+		t.Fatalf("Expected identical fronts, Actual %v and %v", first, second)
+	}
+
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("Expected identical fronts, Actual %v and %v", first, second)
+		}
+	}
+}
+
+func TestNSGA2SolvesSingleObjective(t *testing.T) {
+
+	// GIVEN
+	p := sphere.NewProblem(3)
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+
+	s := nsga2.NewSolver()
+	s.SetEpochs(100)
+	s.SetProblem(p)
+	s.SetPopulationSize(20)
+
+	// WHEN
+	best := s.Run()
+
+	// THEN
+	if best.GetFitness() > 0.1 {
+		t.Errorf("Expected a single objective to reduce to a genetic algorithm, Actual %v", best.GetFitness())
+	}
+}
+
+func TestNSGA2AssignsWorstToEveryObjective(t *testing.T) {
+
+	// GIVEN
+	p := &failingZDT1Problem{}
+	p.SetObjectives(objective.Minimisation, objective.Minimisation)
+	p.SetBounds(real.NewUniformBounds(5, 0, 1, real.Clamp))
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+
+	s := nsga2.NewSolver()
+	s.SetEpochs(20)
+	s.SetProblem(p)
+	s.SetPopulationSize(20)
+	s.SetEvaluationPolicy(solver.AssignWorst)
+
+	// WHEN
+	_, err := s.RunContext(context.Background())
+	front := s.(*nsga2.Solver).GetFront()
+
+	// THEN
+	if err != nil {
+		t.Fatalf("Expected no error, Actual %v", err)
+	}
+
+	if len(front) == 0 {
+		t.Fatalf("Expected a Pareto front, Actual none")
+	}
+
+	for _, chromo := range front {
+		if f := chromo.GetFitnesses(); len(f) != 2 || math.IsInf(f[0], 0) || math.IsInf(f[1], 0) {
+			t.Errorf("Expected the failed chromosomes to be dominated off the front, Actual %v", f)
+		}
+	}
+}
+
+func TestNSGA2ClonesParentsWithoutChildren(t *testing.T) {
+
+	// GIVEN
+	p := &childlessProblem{}
+	p.SetObjectives(objective.Minimisation, objective.Minimisation)
+	p.SetBounds(real.NewUniformBounds(3, 0, 1, real.Clamp))
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+
+	s := nsga2.NewSolver()
+	s.SetEpochs(5)
+	s.SetProblem(p)
+	s.SetPopulationSize(11)
+	s.SetCrossoverRate(1)
+
+	// WHEN
+	_, err := s.RunContext(context.Background())
+
+	// THEN
+	if err != nil {
+		t.Fatalf("Expected no error, Actual %v", err)
+	}
+
+	if size := len(s.GetPopulation()); size != 11 {
+		t.Errorf("Expected the population to keep its size, Actual %v", size)
+	}
+}
+
+func TestNSGA2CrossoverSeedsChangeEveryGeneration(t *testing.T) {
+
+	// GIVEN
+	p := &recordingProblem{}
+	p.SetObjectives(objective.Minimisation, objective.Minimisation)
+	p.SetBounds(real.NewUniformBounds(3, 0, 1, real.Clamp))
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+
+	s := nsga2.NewSolver()
+	s.SetEpochs(5)
+	s.SetProblem(p)
+	s.SetPopulationSize(10)
+	s.SetCrossoverRate(1)
+
+	// WHEN
+	_, err := s.RunContext(context.Background())
+
+	// THEN
+	if err != nil {
+		t.Fatalf("Expected no error, Actual %v", err)
+	}
+
+	seen := map[int64]bool{}
+	for _, seed := range p.seeds {
+		seen[seed] = true
+	}
+
+	if len(p.seeds) < 10 || len(seen) != len(p.seeds) {
+		t.Errorf("Expected every crossover of every generation to have its own seed, Actual %v distinct of %v", len(seen), len(p.seeds))
+	}
+}
+
+func TestNSGA2ValidationReportsEveryError(t *testing.T) {
+
+	// GIVEN
+	p := zdt1.NewProblem(5)
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+
+	s := nsga2.NewSolver()
+	s.SetProblem(p)
+	s.SetPopulationSize(10)
+	s.SetEpochs(-2)
+	s.(*nsga2.Solver).SetMutationProbability(2)
+
+	// WHEN
+	err := s.Validate()
+
+	// THEN
+	if !errors.Is(err, solver.ErrInvalidEpochs) || !errors.Is(err, solver.ErrInvalidRate) {
+		t.Errorf("Expected errors for the epochs and the mutation probability, Actual %v", err)
+	}
+}
+
+func TestMultiEvaluatorSetsFitnessesAndReportsErrors(t *testing.T) {
+
+	// GIVEN
+	p := &fallibleZDT1Problem{}
+	p.SetObjectives(objective.Minimisation, objective.Minimisation)
+	p.SetBounds(real.NewUniformBounds(3, 0, 1, real.Clamp))
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+
+	feasible := p.GenerateChromosome()
+	feasible.(*real.Chromosome).Phenotype = []float64{0.25, 0, 0}
+	infeasible := p.GenerateChromosome()
+	infeasible.(*real.Chromosome).Phenotype = []float64{0.75, 0, 0}
+
+	// WHEN
+	feasibleErr := problem.Evaluate(context.Background(), p, &feasible)
+	infeasibleErr := problem.Evaluate(context.Background(), p, &infeasible)
+
+	// THEN
+	if feasibleErr != nil {
+		t.Fatalf("Expected no error, Actual %v", feasibleErr)
+	}
+
+	if f := feasible.GetFitnesses(); len(f) != 2 || f[0] != 0.25 || f[1] != 0.5 {
+		t.Errorf("Expected the fitnesses [0.25 0.5], Actual %v", f)
+	}
+
+	if !errors.Is(infeasibleErr, errInfeasible) {
+		t.Errorf("Expected the error of the evaluator, Actual %v", infeasibleErr)
+	}
+}
@@ -0,0 +1,86 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/examples/onemax"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/solver"
+	"github.com/opticverge/goevolution/stats"
+)
+
+type recordingObserver struct {
+	solver.Observer
+	solver      solver.ISolver
+	initialised int
+	starts      int
+	ends        int
+	bests       int
+	reason      string
+}
+
+func (r *recordingObserver) OnInitialised(s solver.ISolver) {
+	r.solver = s
+	r.initialised++
+}
+
+func (r *recordingObserver) OnGenerationStart(generation int) {
+	r.starts++
+}
+
+func (r *recordingObserver) OnGenerationEnd(statistics stats.Statistics) {
+	r.ends++
+	if statistics.Generation == 3 {
+		r.solver.Stop("stopped by observer")
+	}
+}
+
+func (r *recordingObserver) OnNewBest(best chromosome.IChromosome) {
+	r.bests++
+}
+
+func (r *recordingObserver) OnTerminated(reason string) {
+	r.reason = reason
+}
+
+func TestSolverNotifiesObservers(t *testing.T) {
+
+	// GIVEN
+	p := onemax.NewProblem()
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	p.SetDimensions(8)
+
+	first := &recordingObserver{}
+	second := &recordingObserver{}
+
+	s := solver.NewSolver()
+	s.SetEpochs(10)
+	s.SetProblem(p)
+	s.SetPopulationSize(10)
+	s.AddObserver(first)
+	s.AddObserver(second)
+
+	// WHEN
+	s.Run()
+
+	// THEN
+	for _, observer := range []*recordingObserver{first, second} {
+		if observer.initialised != 1 {
+			t.Errorf("Expected OnInitialised to be called once, Actual %v", observer.initialised)
+		}
+
+		if observer.starts != 2 || observer.ends != 2 {
+			t.Errorf("Expected 2 generations to be observed, Actual %v starts and %v ends", observer.starts, observer.ends)
+		}
+
+		if observer.bests == 0 {
+			t.Errorf("Expected OnNewBest to be called at least once")
+		}
+
+		if observer.reason != "stopped by observer" {
+			t.Errorf("Expected termination reason to be %q, Actual %q", "stopped by observer", observer.reason)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/opticverge/goevolution/chromosome/permutation"
+	"github.com/opticverge/goevolution/generator"
+)
+
+func TestPermutationMutationsKeepValidity(t *testing.T) {
+
+	// GIVEN
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	mutations := []permutation.IMutation{
+		permutation.NewSwapMutation(),
+		permutation.NewInsertionMutation(),
+		permutation.NewInversionMutation(),
+		permutation.NewScrambleMutation(),
+	}
+
+	for _, mutation := range mutations {
+		for trial := 0; trial < 50; trial++ {
+			phenotype := rng.Permutation(20)
+
+			// WHEN
+			mutation.Mutate(phenotype, 0.3, rng)
+
+			// THEN
+			if !permutation.Valid(phenotype) {
+				t.Fatalf("Expected %T to keep a valid permutation, Actual %v", mutation, phenotype)
+			}
+		}
+	}
+}
+
+func TestPermutationCrossoversKeepValidity(t *testing.T) {
+
+	// GIVEN
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	crossovers := []permutation.ICrossover{
+		permutation.NewPartiallyMappedCrossover(),
+		permutation.NewOrderCrossover(),
+		permutation.NewCycleCrossover(),
+		permutation.NewEdgeRecombinationCrossover(),
+	}
+
+	for _, crossover := range crossovers {
+		for trial := 0; trial < 50; trial++ {
+			first, second := rng.Permutation(20), rng.Permutation(20)
+
+			// WHEN
+			children := crossover.Crossover(first, second, rng)
+
+			// THEN
+			if len(children) != 2 {
+				t.Fatalf("Expected %T to produce %v children, Actual %v", crossover, 2, len(children))
+			}
+
+			for _, child := range children {
+				if !permutation.Valid(child) {
+					t.Fatalf("Expected %T to produce a valid permutation, Actual %v", crossover, child)
+				}
+			}
+		}
+	}
+}
+
+func TestCycleCrossoverKeepsPositions(t *testing.T) {
+
+	// GIVEN
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	first := []int{0, 1, 2, 3, 4, 5, 6, 7}
+	second := []int{7, 2, 1, 0, 4, 6, 5, 3}
+
+	// WHEN
+	children := permutation.NewCycleCrossover().Crossover(first, second, rng)
+
+	// THEN
+	for _, child := range children {
+		for i := range child {
+			if child[i] != first[i] && child[i] != second[i] {
+				t.Errorf("Expected gene at %v to come from a parent, Actual %v", i, child[i])
+			}
+		}
+	}
+}
+
+func TestEdgeRecombinationOfIdenticalParents(t *testing.T) {
+
+	// GIVEN
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	parent := []int{3, 1, 4, 0, 2}
+
+	// WHEN
+	children := permutation.NewEdgeRecombinationCrossover().Crossover(parent, parent, rng)
+
+	// THEN
+	positions := permutation.Positions(parent)
+	for _, child := range children {
+		for i := range child {
+			gap := positions[child[i]] - positions[child[(i+1)%len(child)]]
+			if gap != 1 && gap != -1 && gap != len(parent)-1 && gap != 1-len(parent) {
+				t.Fatalf("Expected child to retrace the tour of the parent, Actual %v", child)
+			}
+		}
+	}
+}
+
+func TestPermutationProblemGeneratesDistinctChromosomes(t *testing.T) {
+
+	// GIVEN
+	p := &permutation.Problem{}
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	p.SetDimensions(20)
+	first := p.GenerateChromosome()
+	second := p.GenerateChromosome()
+
+	// WHEN
+	first.Generate()
+	second.Generate()
+
+	// THEN
+	if reflect.DeepEqual(first.GetPhenotype(), second.GetPhenotype()) {
+		t.Errorf("Expected every generated chromosome to have its own seed, Actual %v twice", first.GetPhenotype())
+	}
+}
@@ -0,0 +1,181 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/opticverge/goevolution/examples/onemax"
+	"github.com/opticverge/goevolution/examples/sphere"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/solver"
+	"github.com/opticverge/goevolution/solver/pso"
+)
+
+func TestParticleSwarmTopologies(t *testing.T) {
+
+	// GIVEN
+	cases := []struct {
+		topology     pso.Topology
+		inertia      pso.IInertia
+		constriction bool
+	}{
+		{pso.GlobalBest, pso.NewConstantInertia(0.7298), false},
+		{pso.GlobalBest, pso.NewLinearInertia(0.9, 0.4), false},
+		{pso.Ring, pso.NewConstantInertia(0.7298), false},
+		{pso.VonNeumann, pso.NewConstantInertia(0.7298), false},
+		{pso.Ring, nil, true},
+	}
+
+	for _, c := range cases {
+		p := sphere.NewProblem(5)
+		p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+
+		s := pso.NewSolver(c.topology)
+		s.SetEpochs(300)
+		s.SetProblem(p)
+		s.SetPopulationSize(30)
+
+		swarm := s.(*pso.Solver)
+		swarm.SetInertia(c.inertia)
+		if c.constriction {
+			swarm.SetConstriction(true)
+			swarm.SetAcceleration(2.05, 2.05)
+		}
+
+		// WHEN
+		best, err := s.RunContext(context.Background())
+
+		// THEN
+		if err != nil {
+			t.Fatalf("Expected no error for %v, Actual %v", c.topology, err)
+		}
+
+		if best.GetFitness() > 1e-3 {
+			t.Errorf("Expected %v with %T to approach the optimum, Actual %v", c.topology, c.inertia, best.GetFitness())
+		}
+	}
+}
+
+func TestVonNeumannNeighboursWrapRowsAndColumns(t *testing.T) {
+
+	// GIVEN
+	// a swarm of 10 is a grid of 4 columns with a last row of 2
+	//  0  1  2  3
+	//  4  5  6  7
+	//  8  9
+	cases := map[int][]int{
+		0: {0, 3, 1, 8, 4},
+		3: {3, 2, 0, 7, 7},
+		4: {4, 7, 5, 0, 8},
+		7: {7, 6, 4, 3, 3},
+		9: {9, 8, 8, 5, 1},
+	}
+
+	for index, expected := range cases {
+
+		// WHEN
+		neighbours := pso.VonNeumann.Neighbours(index, 10)
+
+		// THEN
+		if !reflect.DeepEqual(neighbours, expected) {
+			t.Errorf("Expected the neighbours of %v to be %v, Actual %v", index, expected, neighbours)
+		}
+	}
+}
+
+func TestParticleSwarmClampsVelocity(t *testing.T) {
+
+	// GIVEN
+	p := sphere.NewProblem(3)
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+
+	s := pso.NewSolver(pso.GlobalBest)
+	s.SetEpochs(20)
+	s.SetProblem(p)
+	s.SetPopulationSize(10)
+	s.(*pso.Solver).SetInertia(pso.NewConstantInertia(1.2))
+	s.(*pso.Solver).SetVelocityClamp(0.1)
+
+	// WHEN
+	s.Run()
+
+	// THEN
+	limit := 0.1 * 10.24
+	for _, velocity := range s.(*pso.Solver).GetVelocities() {
+		for _, v := range velocity {
+			if math.Abs(v) > limit+1e-9 {
+				t.Fatalf("Expected speeds of at most %v, Actual %v", limit, v)
+			}
+		}
+	}
+}
+
+func TestParticleSwarmIsReproducible(t *testing.T) {
+
+	// GIVEN
+	seed := time.Now().UnixNano()
+	run := func() float64 {
+		p := sphere.NewProblem(5)
+		p.SetGenerator(generator.NewRandomGenerator(seed))
+		s := pso.NewSolver(pso.VonNeumann)
+		s.SetEpochs(30)
+		s.SetProblem(p)
+		s.SetPopulationSize(20)
+		s.(*pso.Solver).SetInertia(pso.NewRandomInertia())
+		return s.Run().GetFitness()
+	}
+
+	// WHEN
+	first, second := run(), run()
+
+	// THEN
+	if first != second {
+		t.Errorf("Expected identical runs, Actual %v and %v", first, second)
+	}
+}
+
+func TestParticleSwarmValidation(t *testing.T) {
+
+	// GIVEN
+	p := onemax.NewProblem()
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	p.SetDimensions(8)
+
+	s := pso.NewSolver(pso.Ring)
+	s.SetProblem(p)
+	s.SetPopulationSize(10)
+	s.(*pso.Solver).SetConstriction(true)
+
+	// WHEN
+	err := s.Validate()
+
+	// THEN
+	if !errors.Is(err, solver.ErrIncompatibleStrategy) {
+		t.Errorf("Expected errors for the coefficients and the problem, Actual %v", err)
+	}
+}
+
+func TestParticleSwarmValidationReportsEveryError(t *testing.T) {
+
+	// GIVEN
+	p := sphere.NewProblem(5)
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+
+	s := pso.NewSolver(pso.GlobalBest)
+	s.SetProblem(p)
+	s.SetPopulationSize(10)
+	s.SetEpochs(-2)
+	s.(*pso.Solver).SetTopology(pso.Topology("Star"))
+
+	// WHEN
+	err := s.Validate()
+
+	// THEN
+	if !errors.Is(err, solver.ErrInvalidEpochs) || !errors.Is(err, solver.ErrIncompatibleStrategy) {
+		t.Errorf("Expected errors for the epochs and the topology, Actual %v", err)
+	}
+}
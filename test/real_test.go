@@ -0,0 +1,102 @@
+package test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/chromosome/real"
+	"github.com/opticverge/goevolution/examples/sphere"
+	"github.com/opticverge/goevolution/generator"
+)
+
+func TestBoundsRepairPolicies(t *testing.T) {
+
+	// GIVEN
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	cases := []struct {
+		policy   real.BoundPolicy
+		value    float64
+		expected float64
+	}{
+		{real.Clamp, 12, 10},
+		{real.Clamp, -3, 0},
+		{real.Reflect, 12, 8},
+		{real.Reflect, -3, 3},
+		{real.Wrap, 12, 2},
+		{real.Wrap, -3, 7},
+	}
+
+	for _, c := range cases {
+		bounds := real.NewUniformBounds(1, 0, 10, c.policy)
+
+		// WHEN
+		actual := bounds.RepairGene(0, c.value, rng)
+
+		// THEN
+		if math.Abs(actual-c.expected) > 1e-9 {
+			t.Errorf("Expected %v to repair %v to %v, Actual %v", c.policy, c.value, c.expected, actual)
+		}
+	}
+}
+
+func TestRealOperatorsStayWithinBounds(t *testing.T) {
+
+	// GIVEN
+	dimensions := 8
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	bounds := real.NewUniformBounds(dimensions, -1, 1, real.Reflect)
+	mutations := []real.IMutation{
+		real.NewGaussianMutation(0.5),
+		real.NewCauchyMutation(0.5),
+		real.NewPolynomialMutation(20),
+		real.NewUniformMutation(),
+	}
+	crossovers := []real.ICrossover{
+		real.NewBlendCrossover(0.5),
+		real.NewSimulatedBinaryCrossover(2),
+		real.NewArithmeticCrossover(-1),
+	}
+
+	for i, mutation := range mutations {
+		first := real.NewChromosome(dimensions, rng, bounds).(*real.Chromosome)
+		second := real.NewChromosome(dimensions, rng, bounds).(*real.Chromosome)
+		first.SetMutation(mutation)
+		first.SetCrossover(crossovers[i%len(crossovers)])
+		first.Generate()
+		second.Generate()
+
+		// WHEN
+		first.Mutate(1.0)
+		children := first.Crossover(second, rng)
+
+		// THEN
+		for _, c := range append(children, chromosome.IChromosome(first)) {
+			for d, value := range c.(*real.Chromosome).Phenotype {
+				if !bounds.Contains(d, value) {
+					t.Errorf("Expected gene %v to be within bounds, Actual %v", d, value)
+				}
+			}
+		}
+	}
+}
+
+func TestRealProblemGeneratesDistinctChromosomes(t *testing.T) {
+
+	// GIVEN
+	p := sphere.NewProblem(5)
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	first := p.GenerateChromosome()
+	second := p.GenerateChromosome()
+
+	// WHEN
+	first.Generate()
+	second.Generate()
+
+	// THEN
+	if reflect.DeepEqual(first.GetPhenotype(), second.GetPhenotype()) {
+		t.Errorf("Expected every generated chromosome to have its own seed, Actual %v twice", first.GetPhenotype())
+	}
+}
@@ -0,0 +1,144 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/examples/onemax"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/mutation"
+	"github.com/opticverge/goevolution/objective"
+	"github.com/opticverge/goevolution/replacement"
+	"github.com/opticverge/goevolution/solver"
+)
+
+func noImmigrants(count int) []chromosome.IChromosome {
+	return newFitnessPopulation(make([]float64, count)...)
+}
+
+func TestReplacersKeepPopulationSize(t *testing.T) {
+
+	// GIVEN
+	replacers := []replacement.IReplacer{
+		replacement.NewGenerationalReplacer(),
+		replacement.NewSteadyStateReplacer(2),
+		replacement.NewMuPlusLambdaReplacer(),
+		replacement.NewMuCommaLambdaReplacer(),
+		replacement.NewElitistReplacer(1),
+		replacement.NewRandomImmigrantReplacer(0.2),
+	}
+
+	for _, replacer := range replacers {
+		population := newFitnessPopulation(1, 2, 3, 4, 5)
+		offspring := newFitnessPopulation(6, 7)
+
+		// WHEN
+		survivors := replacer.Replace(population, offspring, len(population), objective.Maximisation, noImmigrants)
+
+		// THEN
+		if len(survivors) != len(population) {
+			t.Errorf("Expected %T to keep %v chromosomes, Actual %v", replacer, len(population), len(survivors))
+		}
+	}
+}
+
+func TestMuPlusLambdaReplacerKeepsBest(t *testing.T) {
+
+	// GIVEN
+	population := newFitnessPopulation(5, 1, 3)
+	offspring := newFitnessPopulation(2, 4)
+
+	// WHEN
+	survivors := replacement.NewMuPlusLambdaReplacer().Replace(population, offspring, 3, objective.Minimisation, noImmigrants)
+
+	// THEN
+	for i, expected := range []float64{1, 2, 3} {
+		if survivors[i].GetFitness() != expected {
+			t.Errorf("Expected survivor %v to have fitness %v, Actual %v", i, expected, survivors[i].GetFitness())
+		}
+	}
+}
+
+func TestElitistReplacerKeepsElites(t *testing.T) {
+
+	// GIVEN
+	population := newFitnessPopulation(10, 9, 8)
+	offspring := newFitnessPopulation(1, 2, 3)
+
+	// WHEN
+	survivors := replacement.NewElitistReplacer(1).Replace(population, offspring, 3, objective.Maximisation, noImmigrants)
+
+	// THEN
+	if survivors[0].GetFitness() != 10 {
+		t.Errorf("Expected the elite to survive with fitness %v, Actual %v", 10, survivors[0].GetFitness())
+	}
+
+	if survivors[1].GetFitness() != 3 || survivors[2].GetFitness() != 2 {
+		t.Errorf("Expected the remainder to be the best offspring")
+	}
+}
+
+func TestGenerationalReplacerKeepsFitChildren(t *testing.T) {
+
+	// GIVEN
+	population := newFitnessPopulation(1, 1, 1)
+
+	// the solver appends the crossover children after one mutant per parent
+	mutants := newFitnessPopulation(2, 3, 4)
+	children := newFitnessPopulation(8, 9)
+	offspring := append(append([]chromosome.IChromosome(nil), mutants...), children...)
+
+	// WHEN
+	survivors := replacement.NewGenerationalReplacer().Replace(population, offspring, len(population), objective.Maximisation, noImmigrants)
+
+	// THEN
+	if survivors[0] != children[1] || survivors[1] != children[0] || survivors[2] != mutants[2] {
+		t.Errorf("Expected the children to survive ahead of the weaker mutants, Actual %v, %v and %v", survivors[0].GetFitness(), survivors[1].GetFitness(), survivors[2].GetFitness())
+	}
+}
+
+// discardingReplacer is a custom replacer which opts in to discarding the
+// parents
+type discardingReplacer struct {
+	replacement.MuPlusLambdaReplacer
+}
+
+func (r *discardingReplacer) DiscardsParents() bool {
+	return true
+}
+
+func TestParentDiscardingReplacersReceiveUnimprovedClones(t *testing.T) {
+
+	// GIVEN
+	discards := map[replacement.IReplacer]bool{
+		replacement.NewMuCommaLambdaReplacer(): true,
+		&discardingReplacer{}:                  true,
+		replacement.NewGenerationalReplacer():  false,
+	}
+
+	for replacer, discarded := range discards {
+		p := onemax.NewProblem()
+		p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+		p.SetDimensions(8)
+
+		s := solver.NewSolver()
+		s.SetProblem(p)
+		s.SetPopulationSize(1)
+		s.SetReplacer(replacer)
+		s.SetMutationStrategy(mutation.NewConstantStrategy(3, 0.5))
+
+		// no clone can beat a parent with an unreachable fitness
+		parent := p.GenerateChromosome()
+		parent.Generate()
+		parent.SetFitness(100)
+
+		// WHEN
+		survivor := s.(*solver.Solver).MutateChromosomes(parent, 0)
+
+		// THEN
+		if (survivor != parent) != discarded {
+			t.Errorf("Expected %T to discard the parent: %v, Actual %v", replacer, discarded, survivor != parent)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/opticverge/goevolution/examples/onemax"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/mutation"
+	"github.com/opticverge/goevolution/selection"
+	"github.com/opticverge/goevolution/solver"
+	"github.com/opticverge/goevolution/stats"
+)
+
+func runSeededOneMax(seed int64) (interface{}, []stats.Statistics) {
+	p := onemax.NewProblem()
+	p.SetGenerator(generator.NewRandomGenerator(seed))
+	p.SetDimensions(32)
+
+	s := solver.NewSolver()
+	s.SetEpochs(10)
+	s.SetProblem(p)
+	s.SetPopulationSize(20)
+	s.SetSelector(selection.NewTournamentSelector(2))
+	s.SetMutationStrategy(mutation.NewSelfAdaptiveStrategy(0.1, 5))
+
+	best := s.Run()
+
+	history := s.GetStatistics()
+	for i := range history {
+		history[i].Elapsed = 0
+	}
+
+	return best.GetPhenotype(), history
+}
+
+func TestSolverRunsAreReproducible(t *testing.T) {
+
+	// GIVEN
+	seed := time.Now().UnixNano()
+
+	// WHEN
+	firstBest, firstHistory := runSeededOneMax(seed)
+	secondBest, secondHistory := runSeededOneMax(seed)
+
+	// THEN
+	if !reflect.DeepEqual(firstBest, secondBest) {
+		t.Errorf("Expected the best chromosomes of seed %v to be identical", seed)
+	}
+
+	if !reflect.DeepEqual(firstHistory, secondHistory) {
+		t.Errorf("Expected the statistics of seed %v to be identical", seed)
+	}
+}
+
+func TestDeriveSeed(t *testing.T) {
+
+	// GIVEN
+	seed := int64(42)
+
+	// WHEN
+	first := generator.DeriveSeed(seed, 1, 2)
+	second := generator.DeriveSeed(seed, 1, 2)
+	swapped := generator.DeriveSeed(seed, 2, 1)
+
+	// THEN
+	if first != second {
+		t.Errorf("Expected derived seeds to be equal, Actual %v and %v", first, second)
+	}
+
+	if first == swapped {
+		t.Errorf("Expected derived seeds of different paths to differ")
+	}
+}
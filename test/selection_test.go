@@ -0,0 +1,140 @@
+package test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/examples/onemax"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/objective"
+	"github.com/opticverge/goevolution/selection"
+)
+
+func newFitnessPopulation(fitnesses ...float64) []chromosome.IChromosome {
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	population := make([]chromosome.IChromosome, len(fitnesses))
+	for i, fitness := range fitnesses {
+		population[i] = onemax.NewChromosome(1, rng)
+		population[i].Generate()
+		population[i].SetFitness(fitness)
+	}
+	return population
+}
+
+func TestSelectorsSelectCount(t *testing.T) {
+
+	// GIVEN
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	population := newFitnessPopulation(1, 2, 3, 4, 5)
+	selectors := []selection.ISelector{
+		selection.NewTournamentSelector(2),
+		selection.NewRouletteSelector(),
+		selection.NewRankSelector(1.5),
+		selection.NewStochasticUniversalSelector(),
+	}
+
+	for _, selector := range selectors {
+
+		// WHEN
+		selected := selector.Select(population, 7, objective.Maximisation, rng)
+
+		// THEN
+		if len(selected) != 7 {
+			t.Errorf("Expected selected length to be %v, Actual %v", 7, len(selected))
+		}
+	}
+}
+
+func TestTournamentSelectorRespectsObjective(t *testing.T) {
+
+	// GIVEN
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	population := newFitnessPopulation(1, 2, 3, 4, 5)
+	selector := selection.NewTournamentSelector(len(population) * 10)
+
+	// WHEN
+	maximised := selector.Select(population, 1, objective.Maximisation, rng)
+	minimised := selector.Select(population, 1, objective.Minimisation, rng)
+
+	// THEN
+	if maximised[0].GetFitness() != 5 {
+		t.Errorf("Expected maximisation winner to be %v, Actual %v", 5, maximised[0].GetFitness())
+	}
+
+	if minimised[0].GetFitness() != 1 {
+		t.Errorf("Expected minimisation winner to be %v, Actual %v", 1, minimised[0].GetFitness())
+	}
+}
+
+func TestRouletteSelectorTreatsWorstAlikeWithAndWithoutFailures(t *testing.T) {
+
+	// GIVEN
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	clean := newFitnessPopulation(-10, -5, 0)
+	failed := newFitnessPopulation(-10, -5, 0, math.Inf(1))
+
+	// WHEN
+	share := func(population []chromosome.IChromosome) float64 {
+		worst := 0
+		for _, c := range selection.NewRouletteSelector().Select(population, 3000, objective.Minimisation, rng) {
+			if c.GetFitness() == 0 {
+				worst++
+			}
+		}
+		return float64(worst) / 3000
+	}
+	cleanShare, failedShare := share(clean), share(failed)
+
+	// THEN
+	// the weights 15, 10 and 5 give the worst chromosome a sixth of the
+	// selections in both populations
+	for _, got := range []float64{cleanShare, failedShare} {
+		if math.Abs(got-1.0/6) > 0.04 {
+			t.Errorf("Expected the worst chromosome to be selected about %.3f of the time, Actual %.3f without and %.3f with failures", 1.0/6, cleanShare, failedShare)
+			break
+		}
+	}
+}
+
+func TestWeightedSelectorsSkipFailedChromosomes(t *testing.T) {
+
+	// GIVEN
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	selectors := []selection.ISelector{
+		selection.NewRouletteSelector(),
+		selection.NewStochasticUniversalSelector(),
+	}
+	cases := []struct {
+		fitnesses []float64
+		weakest   float64
+	}{
+		{[]float64{5, 4, math.Inf(-1)}, 4},
+		{[]float64{1, 4, math.Inf(-1), 5}, 1},
+	}
+
+	for _, selector := range selectors {
+		for _, c := range cases {
+			population := newFitnessPopulation(c.fitnesses...)
+
+			// WHEN
+			selected := selector.Select(population, 200, objective.Maximisation, rng)
+
+			// THEN
+			weakest := 0
+			for _, chromo := range selected {
+				if math.IsInf(chromo.GetFitness(), 0) {
+					t.Fatalf("Expected %T never to select a failed chromosome", selector)
+				}
+				if chromo.GetFitness() == c.weakest {
+					weakest++
+				}
+			}
+
+			if weakest == 0 {
+				t.Errorf("Expected %T to select the weakest finite fitness %v from %v", selector, c.weakest, c.fitnesses)
+			}
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/opticverge/goevolution/examples/onemax"
 	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/selection"
 	"github.com/opticverge/goevolution/solver"
 )
 
@@ -37,3 +38,27 @@ func TestSolverEvolution(t *testing.T) {
 		t.Errorf("Expected population size to be %v not %v", populationSize, len(s.GetPopulation()))
 	}
 }
+
+func TestSolverEvolutionWithSelector(t *testing.T) {
+	populationSize := 10
+
+	p := onemax.NewProblem()
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	p.SetDimensions(8)
+
+	s := solver.NewSolver()
+	s.SetEpochs(2)
+	s.SetProblem(p)
+	s.SetPopulationSize(populationSize)
+	s.SetSelector(selection.NewTournamentSelector(3))
+
+	bestChromosome := s.Run()
+
+	if bestChromosome == nil {
+		t.Errorf("Expected output of solver.Run() to produce an IChromosome not nil")
+	}
+
+	if len(s.GetPopulation()) != populationSize {
+		t.Errorf("Expected population size to be %v not %v", populationSize, len(s.GetPopulation()))
+	}
+}
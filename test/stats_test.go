@@ -0,0 +1,123 @@
+package test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/opticverge/goevolution/examples/onemax"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/objective"
+	"github.com/opticverge/goevolution/solver"
+	"github.com/opticverge/goevolution/stats"
+)
+
+func TestStatisticsOfPopulation(t *testing.T) {
+
+	// GIVEN
+	population := newFitnessPopulation(4, 1, 3, 2)
+
+	// WHEN
+	statistics := stats.NewStatistics(population, objective.Minimisation)
+
+	// THEN
+	if statistics.BestFitness != 1 || statistics.WorstFitness != 4 {
+		t.Errorf("Expected best and worst to be 1 and 4, Actual %v and %v", statistics.BestFitness, statistics.WorstFitness)
+	}
+
+	if statistics.MeanFitness != 2.5 || statistics.MedianFitness != 2.5 {
+		t.Errorf("Expected mean and median to be 2.5, Actual %v and %v", statistics.MeanFitness, statistics.MedianFitness)
+	}
+
+	if math.Abs(statistics.StdDevFitness-math.Sqrt(1.25)) > 1e-9 {
+		t.Errorf("Expected standard deviation to be %v, Actual %v", math.Sqrt(1.25), statistics.StdDevFitness)
+	}
+}
+
+func TestStatisticsLeaveOutFailedChromosomes(t *testing.T) {
+
+	// GIVEN
+	population := newFitnessPopulation(4, 1, math.Inf(1), 3, 2, math.NaN())
+
+	// WHEN
+	statistics := stats.NewFitnessStatistics(population, objective.Minimisation)
+
+	// THEN
+	if statistics.Failures != 2 {
+		t.Errorf("Expected 2 failures, Actual %v", statistics.Failures)
+	}
+
+	if statistics.BestFitness != 1 || statistics.WorstFitness != 4 {
+		t.Errorf("Expected best and worst to be 1 and 4, Actual %v and %v", statistics.BestFitness, statistics.WorstFitness)
+	}
+
+	if statistics.MeanFitness != 2.5 || statistics.MedianFitness != 2.5 {
+		t.Errorf("Expected mean and median to be 2.5, Actual %v and %v", statistics.MeanFitness, statistics.MedianFitness)
+	}
+
+	if math.Abs(statistics.StdDevFitness-math.Sqrt(1.25)) > 1e-9 {
+		t.Errorf("Expected standard deviation to be %v, Actual %v", math.Sqrt(1.25), statistics.StdDevFitness)
+	}
+}
+
+func TestSolverRecordsStatisticsHistory(t *testing.T) {
+
+	// GIVEN
+	epochs := 5
+
+	p := onemax.NewProblem()
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	p.SetDimensions(8)
+
+	s := solver.NewSolver()
+	s.SetEpochs(epochs)
+	s.SetProblem(p)
+	s.SetPopulationSize(10)
+	s.SetDiversity(true)
+
+	// WHEN
+	s.Run()
+	history := s.GetStatistics()
+
+	// THEN
+	if len(history) != epochs {
+		t.Fatalf("Expected %v generations of statistics, Actual %v", epochs, len(history))
+	}
+
+	for i, statistics := range history {
+		if statistics.Generation != i+1 {
+			t.Errorf("Expected generation %v, Actual %v", i+1, statistics.Generation)
+		}
+
+		if !statistics.HasDiversity {
+			t.Errorf("Expected diversity to be measured for OneMax chromosomes")
+		}
+
+		if i > 0 && statistics.Evaluations < history[i-1].Evaluations {
+			t.Errorf("Expected evaluations to accumulate")
+		}
+	}
+}
+
+func TestSolverSkipsDiversityByDefault(t *testing.T) {
+
+	// GIVEN
+	p := onemax.NewProblem()
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	p.SetDimensions(8)
+
+	s := solver.NewSolver()
+	s.SetEpochs(3)
+	s.SetProblem(p)
+	s.SetPopulationSize(10)
+
+	// WHEN
+	s.Run()
+
+	// THEN
+	for _, statistics := range s.GetStatistics() {
+		if statistics.HasDiversity {
+			t.Fatalf("Expected diversity to be measured only when enabled")
+		}
+	}
+}
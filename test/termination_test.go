@@ -0,0 +1,95 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/opticverge/goevolution/examples/onemax"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/solver"
+	"github.com/opticverge/goevolution/termination"
+)
+
+func TestSolverStopsAtTargetFitness(t *testing.T) {
+
+	// GIVEN
+	dimensions := 8
+	target := termination.NewTargetFitnessCriterion(float64(dimensions))
+
+	// the seed reaches all 8 bits well before the 500 generations
+	p := onemax.NewProblem()
+	p.SetGenerator(generator.NewRandomGenerator(42))
+	p.SetDimensions(dimensions)
+
+	s := solver.NewSolver()
+	s.SetEpochs(-1)
+	s.SetProblem(p)
+	s.SetPopulationSize(10)
+	s.SetTermination(termination.Or(target, termination.NewMaxGenerationsCriterion(500)))
+
+	// WHEN
+	bestChromosome := s.Run()
+
+	// THEN
+	if bestChromosome.GetFitness() != float64(dimensions) {
+		t.Errorf("Expected the best fitness to reach %v, Actual %v", dimensions, bestChromosome.GetFitness())
+	}
+
+	if s.GetTerminationReason() != target.Reason() {
+		t.Errorf("Expected termination reason to be %q, Actual %q", target.Reason(), s.GetTerminationReason())
+	}
+
+	if s.GetGeneration() >= 500 {
+		t.Errorf("Expected the run to stop before 500 generations, Actual %v", s.GetGeneration())
+	}
+
+	if s.GetEvaluations() == 0 {
+		t.Errorf("Expected the solver to count evaluations")
+	}
+}
+
+func TestSolverReportsEpochsReason(t *testing.T) {
+
+	// GIVEN
+	p := onemax.NewProblem()
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	p.SetDimensions(8)
+
+	s := solver.NewSolver()
+	s.SetEpochs(3)
+	s.SetProblem(p)
+	s.SetPopulationSize(10)
+
+	// WHEN
+	s.Run()
+
+	// THEN
+	expected := termination.NewMaxGenerationsCriterion(3).Reason()
+	if s.GetTerminationReason() != expected {
+		t.Errorf("Expected termination reason to be %q, Actual %q", expected, s.GetTerminationReason())
+	}
+}
+
+func TestStagnationCriterion(t *testing.T) {
+
+	// GIVEN
+	p := onemax.NewProblem()
+	p.SetDimensions(4)
+
+	s := solver.NewSolver()
+	s.SetProblem(p)
+	s.SetPopulation(newFitnessPopulation(1))
+
+	criterion := termination.NewStagnationCriterion(2)
+	criterion.Reset()
+
+	// WHEN
+	first := criterion.Met(s)
+	second := criterion.Met(s)
+	third := criterion.Met(s)
+
+	// THEN
+	if first || second || !third {
+		t.Errorf("Expected stagnation after %v unchanged generations, Actual %v %v %v", 2, first, second, third)
+	}
+}
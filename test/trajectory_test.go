@@ -0,0 +1,217 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/opticverge/goevolution/chromosome/binary"
+	"github.com/opticverge/goevolution/examples/onemax"
+	"github.com/opticverge/goevolution/examples/sphere"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/objective"
+	"github.com/opticverge/goevolution/solver"
+	"github.com/opticverge/goevolution/solver/trajectory"
+)
+
+func TestTrajectoryStrategiesSolveOneMax(t *testing.T) {
+
+	// GIVEN
+	strategies := []trajectory.IStrategy{
+		trajectory.NewHillClimbing(),
+		trajectory.NewRandomRestartHillClimbing(200),
+		trajectory.NewOnePlusOne(),
+		trajectory.NewSimulatedAnnealing(2, trajectory.NewGeometricCooling(0.99)),
+		trajectory.NewSimulatedAnnealing(0.5, trajectory.NewLogarithmicCooling()),
+		trajectory.NewSimulatedAnnealing(2, trajectory.NewAdaptiveCooling(0.5, 20, 0.9)),
+	}
+
+	for _, strategy := range strategies {
+		p := onemax.NewProblem()
+		p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+		p.SetDimensions(32)
+
+		s := trajectory.NewSolver(strategy)
+		s.SetEpochs(2000)
+		s.SetProblem(p)
+
+		// WHEN
+		best, err := s.RunContext(context.Background())
+
+		// THEN
+		if err != nil {
+			t.Fatalf("Expected no error for %T, Actual %v", strategy, err)
+		}
+
+		if best.GetFitness() < 30 {
+			t.Errorf("Expected %T to approach the optimum of 32, Actual %v", strategy, best.GetFitness())
+		}
+	}
+}
+
+func TestOnePlusOneAdaptsMutationProbability(t *testing.T) {
+
+	// GIVEN
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	current := binary.NewChromosome(8, rng)
+	current.SetFitness(4)
+	worse := binary.NewChromosome(8, rng)
+	worse.SetFitness(3)
+	better := binary.NewChromosome(8, rng)
+	better.SetFitness(5)
+
+	strategy := trajectory.NewOnePlusOne()
+	strategy.Reset(0.5)
+
+	// WHEN
+	for i := 0; i < 10; i++ {
+		strategy.Accept(current, worse, objective.Maximisation, rng)
+	}
+	shrunk := strategy.Probability()
+
+	for i := 0; i < 10; i++ {
+		strategy.Accept(current, better, objective.Maximisation, rng)
+	}
+	grown := strategy.Probability()
+
+	// THEN
+	if math.Abs(shrunk-0.5*0.85) > 1e-12 {
+		t.Errorf("Expected a window without successes to shrink the probability to %v, Actual %v", 0.5*0.85, shrunk)
+	}
+
+	if math.Abs(grown-0.5) > 1e-12 {
+		t.Errorf("Expected a window of successes to grow the probability back to 0.5, Actual %v", grown)
+	}
+}
+
+func TestOnePlusOneKeepsProbabilityAboveOnePerDimension(t *testing.T) {
+
+	// GIVEN
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	current := binary.NewChromosome(32, rng)
+	current.SetFitness(32)
+	worse := binary.NewChromosome(32, rng)
+	worse.SetFitness(31)
+
+	strategy := trajectory.NewOnePlusOne()
+	strategy.Reset(0.5)
+
+	// WHEN
+	for i := 0; i < 1000; i++ {
+		strategy.Accept(current, worse, objective.Maximisation, rng)
+	}
+
+	// THEN
+	if strategy.Probability() != 1.0/32 {
+		t.Errorf("Expected the probability to stop at one over the dimensions, Actual %v", strategy.Probability())
+	}
+}
+
+func TestRandomRestartHillClimbingRestarts(t *testing.T) {
+
+	// GIVEN
+	p := onemax.NewProblem()
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	p.SetDimensions(8)
+
+	s := trajectory.NewSolver(trajectory.NewRandomRestartHillClimbing(5))
+	s.SetEpochs(500)
+	s.SetProblem(p)
+
+	// WHEN
+	best := s.Run()
+
+	// THEN
+	if restarts := s.(*trajectory.Solver).GetRestarts(); restarts == 0 {
+		t.Errorf("Expected the climb to restart once stalled at the optimum, Actual %v restarts", restarts)
+	}
+
+	if best.GetFitness() != 8 {
+		t.Errorf("Expected the best of every climb to be kept, Actual %v", best.GetFitness())
+	}
+}
+
+func TestSimulatedAnnealingCools(t *testing.T) {
+
+	// GIVEN
+	p := onemax.NewProblem()
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	p.SetDimensions(16)
+
+	strategy := trajectory.NewSimulatedAnnealing(10, trajectory.NewGeometricCooling(0.95))
+	s := trajectory.NewSolver(strategy)
+	s.SetEpochs(100)
+	s.SetProblem(p)
+
+	// WHEN
+	s.Run()
+
+	// THEN
+	temperature := strategy.(*trajectory.SimulatedAnnealing).GetTemperature()
+	if temperature >= 10*0.95*0.95 {
+		t.Errorf("Expected the temperature to fall from 10, Actual %v", temperature)
+	}
+}
+
+func TestTrajectoryIsReproducible(t *testing.T) {
+
+	// GIVEN
+	seed := time.Now().UnixNano()
+	run := func() float64 {
+		p := sphere.NewProblem(5)
+		p.SetGenerator(generator.NewRandomGenerator(seed))
+		s := trajectory.NewSolver(trajectory.NewSimulatedAnnealing(1, trajectory.NewAdaptiveCooling(0.5, 10, 0.9)))
+		s.SetEpochs(200)
+		s.SetProblem(p)
+		s.(*trajectory.Solver).SetNeighbours(4)
+		return s.Run().GetFitness()
+	}
+
+	// WHEN
+	first, second := run(), run()
+
+	// THEN
+	if first != second {
+		t.Errorf("Expected identical runs, Actual %v and %v", first, second)
+	}
+}
+
+func TestTrajectoryValidation(t *testing.T) {
+
+	// GIVEN
+	p := onemax.NewProblem()
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	p.SetDimensions(8)
+
+	s := trajectory.NewSolver(trajectory.NewSimulatedAnnealing(0, nil))
+	s.SetProblem(p)
+	s.SetPopulationSize(10)
+
+	// WHEN
+	err := s.Validate()
+
+	// THEN
+	if !errors.Is(err, solver.ErrInvalidPopulationSize) || !errors.Is(err, solver.ErrIncompatibleStrategy) {
+		t.Errorf("Expected errors for the population size and the strategy, Actual %v", err)
+	}
+}
+
+func TestTrajectoryValidationReportsEveryError(t *testing.T) {
+
+	// GIVEN
+	p := onemax.NewProblem()
+	p.SetDimensions(8)
+
+	s := trajectory.NewSolver(trajectory.NewSimulatedAnnealing(0, nil))
+	s.SetProblem(p)
+
+	// WHEN
+	err := s.Validate()
+
+	// THEN
+	if !errors.Is(err, solver.ErrNoGenerator) || !errors.Is(err, solver.ErrIncompatibleStrategy) {
+		t.Errorf("Expected errors for the generator and the strategy, Actual %v", err)
+	}
+}
@@ -0,0 +1,106 @@
+package test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/objective"
+	"github.com/opticverge/goevolution/typed"
+)
+
+// boolOneMax is the OneMax problem written against the generic API
+type boolOneMax struct{}
+
+func (b boolOneMax) Generate(dimensions int, rng generator.IGenerator) []bool {
+	genome := make([]bool, dimensions)
+	for i := range genome {
+		genome[i] = rng.Float64() > 0.5
+	}
+	return genome
+}
+
+func (b boolOneMax) Mutate(genome []bool, probability float64, rng generator.IGenerator) []bool {
+	for i := range genome {
+		if rng.Float64() < probability {
+			genome[i] = !genome[i]
+		}
+	}
+	return genome
+}
+
+func (b boolOneMax) Clone(genome []bool) []bool {
+	return append([]bool(nil), genome...)
+}
+
+func (b boolOneMax) Crossover(first []bool, second []bool, rng generator.IGenerator) [][]bool {
+	child := b.Clone(first)
+	for i := range child {
+		if rng.Float64() < 0.5 {
+			child[i] = second[i]
+		}
+	}
+	return [][]bool{child}
+}
+
+func (b boolOneMax) Evaluate(ctx context.Context, genome []bool) (float64, error) {
+	fitness := 0
+	for _, gene := range genome {
+		if gene {
+			fitness++
+		}
+	}
+	return float64(fitness), nil
+}
+
+func TestTypedSolver(t *testing.T) {
+
+	// GIVEN
+	dimensions := 16
+	populationSize := 10
+
+	p := typed.NewProblem[[]bool]("Bool One Max", objective.Maximisation, boolOneMax{})
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	p.SetDimensions(dimensions)
+
+	s := typed.NewSolver(p)
+	s.SetEpochs(5)
+	s.SetPopulationSize(populationSize)
+
+	// WHEN
+	best := s.Run()
+
+	// THEN
+	if best == nil {
+		t.Fatalf("Expected the typed solver to produce a chromosome, error %v", s.GetError())
+	}
+
+	if len(best.Genome) != dimensions {
+		t.Errorf("Expected genome length to be %v, Actual %v", dimensions, len(best.Genome))
+	}
+
+	if len(s.GetTypedPopulation()) != populationSize {
+		t.Errorf("Expected population size to be %v, Actual %v", populationSize, len(s.GetTypedPopulation()))
+	}
+}
+
+func TestTypedProblemGeneratesDistinctChromosomes(t *testing.T) {
+
+	// GIVEN
+	p := typed.NewProblem[[]bool]("Bool One Max", objective.Maximisation, boolOneMax{})
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	p.SetDimensions(32)
+	first := p.GenerateChromosome()
+	second := p.GenerateChromosome()
+
+	// WHEN
+	first.Generate()
+	second.Generate()
+
+	// THEN
+	if reflect.DeepEqual(first.GetPhenotype(), second.GetPhenotype()) {
+		t.Errorf("Expected every generated chromosome to have its own seed, Actual %v twice", first.GetPhenotype())
+	}
+}
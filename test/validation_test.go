@@ -0,0 +1,79 @@
+package test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/opticverge/goevolution/examples/onemax"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/replacement"
+	"github.com/opticverge/goevolution/solver"
+)
+
+func TestValidateMissingProblem(t *testing.T) {
+
+	// GIVEN
+	s := solver.NewSolver()
+	s.SetPopulationSize(10)
+
+	// WHEN
+	err := s.Validate()
+
+	// THEN
+	if !errors.Is(err, solver.ErrNoProblem) {
+		t.Errorf("Expected error to be %v, Actual %v", solver.ErrNoProblem, err)
+	}
+}
+
+func TestValidateReportsEveryProblem(t *testing.T) {
+
+	// GIVEN
+	p := onemax.NewProblem()
+
+	s := solver.NewSolver()
+	s.SetProblem(p)
+	s.SetPopulationSize(0)
+	s.SetEpochs(-2)
+
+	// WHEN
+	err := s.Validate()
+
+	// THEN
+	for _, expected := range []error{solver.ErrNoGenerator, solver.ErrInvalidDimensions, solver.ErrInvalidPopulationSize, solver.ErrInvalidEpochs} {
+		if !errors.Is(err, expected) {
+			t.Errorf("Expected error to include %v, Actual %v", expected, err)
+		}
+	}
+
+	var validationError *solver.ValidationError
+	if !errors.As(err, &validationError) {
+		t.Errorf("Expected a ValidationError, Actual %T", err)
+	}
+}
+
+func TestRunReturnsValidationError(t *testing.T) {
+
+	// GIVEN
+	p := onemax.NewProblem()
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	p.SetDimensions(8)
+
+	s := solver.NewSolver()
+	s.SetEpochs(2)
+	s.SetProblem(p)
+	s.SetPopulationSize(5)
+	s.SetReplacer(replacement.NewRandomImmigrantReplacer(0.1))
+
+	// WHEN
+	bestChromosome := s.Run()
+
+	// THEN
+	if bestChromosome != nil {
+		t.Errorf("Expected no chromosome from a misconfigured solver")
+	}
+
+	if !errors.Is(s.GetError(), solver.ErrIncompatibleStrategy) {
+		t.Errorf("Expected error to be %v, Actual %v", solver.ErrIncompatibleStrategy, s.GetError())
+	}
+}
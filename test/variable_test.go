@@ -0,0 +1,168 @@
+package test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/chromosome/variable"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/objective"
+	"github.com/opticverge/goevolution/solver"
+)
+
+// lengthProblem rewards long sequences, so that only the solver keeps the
+// chromosomes within the length limits
+type lengthProblem struct {
+	variable.Problem
+}
+
+func (p *lengthProblem) ObjectiveFunction(chromo *chromosome.IChromosome) {
+	(*chromo).SetFitness(float64((*chromo).GetDimensions()))
+}
+
+func TestVariableMutationsChangeLength(t *testing.T) {
+
+	// GIVEN
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	phenotype := []int{1, 2, 3, 4, 5}
+
+	// WHEN
+	inserted := variable.NewInsertionMutation().Mutate(append([]int(nil), phenotype...), 1, 10, rng)
+	deleted := variable.NewDeletionMutation().Mutate(append([]int(nil), phenotype...), 1, 10, rng)
+
+	// THEN
+	if len(inserted) != 2*len(phenotype)+1 {
+		t.Errorf("Expected %v genes after insertion, Actual %v", 2*len(phenotype)+1, len(inserted))
+	}
+
+	for i, gene := range phenotype {
+		if inserted[2*i+1] != gene {
+			t.Errorf("Expected gene %v to be kept in order, Actual %v", gene, inserted)
+		}
+	}
+
+	if len(deleted) != 0 {
+		t.Errorf("Expected every gene to be deleted, Actual %v", deleted)
+	}
+}
+
+func TestVariableCrossoversKeepGenes(t *testing.T) {
+
+	// GIVEN
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	first := []int{0, 0, 0, 0, 0, 0}
+	second := []int{1, 1, 1}
+	crossovers := []variable.ICrossover{
+		variable.NewCutAndSpliceCrossover(),
+		variable.NewMessyCrossover(0.2, 0.5),
+	}
+
+	for _, crossover := range crossovers {
+		for trial := 0; trial < 50; trial++ {
+
+			// WHEN
+			children := crossover.Crossover(first, second, rng)
+
+			// THEN
+			counts := map[int]int{}
+			for _, child := range children {
+				for _, gene := range child {
+					counts[gene]++
+				}
+			}
+
+			if counts[0] != len(first) || counts[1] != len(second) {
+				t.Fatalf("Expected %T to keep every gene of the parents, Actual %v", crossover, children)
+			}
+		}
+	}
+}
+
+func TestVariableEditDistance(t *testing.T) {
+
+	// GIVEN
+	rng := generator.NewRandomGenerator(time.Now().UnixNano())
+	first := variable.NewChromosome(0, rng, 10).(*variable.Chromosome)
+	second := variable.NewChromosome(0, rng, 10).(*variable.Chromosome)
+	first.Phenotype = []int{1, 2, 3, 4}
+	second.Phenotype = []int{2, 3, 5, 4, 6}
+
+	// WHEN
+	distance := first.Distance(second)
+
+	// THEN
+	if distance != 3 {
+		t.Errorf("Expected edit distance to be %v, Actual %v", 3, distance)
+	}
+}
+
+func TestSolverEnforcesLengthLimits(t *testing.T) {
+
+	// GIVEN
+	p := &lengthProblem{}
+	p.SetName("Length")
+	p.SetObjective(objective.Maximisation)
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	p.SetDimensions(5)
+	p.SetAlphabet(4)
+	p.SetInitialLengths(1, 12)
+
+	s := solver.NewSolver()
+	s.SetEpochs(10)
+	s.SetProblem(p)
+	s.SetPopulationSize(20)
+	s.SetLengthLimits(3, 8)
+
+	// WHEN
+	best := s.Run()
+
+	// THEN
+	for _, c := range s.GetPopulation() {
+		length := len(c.(*variable.Chromosome).Phenotype)
+		if length < 3 || length > 8 || length != c.GetDimensions() {
+			t.Errorf("Expected a length between 3 and 8 matching the dimensions, Actual %v and %v", length, c.GetDimensions())
+		}
+	}
+
+	if best.GetFitness() != 8 {
+		t.Errorf("Expected the longest chromosome to reach the limit of %v, Actual %v", 8, best.GetFitness())
+	}
+}
+
+func TestInvalidLengthLimits(t *testing.T) {
+
+	// GIVEN
+	s := solver.NewSolver()
+	s.SetLengthLimits(5, 2)
+
+	// WHEN
+	err := s.Validate()
+
+	// THEN
+	if !errors.Is(err, solver.ErrInvalidLength) {
+		t.Errorf("Expected error to wrap %v, Actual %v", solver.ErrInvalidLength, err)
+	}
+}
+
+func TestVariableProblemGeneratesDistinctChromosomes(t *testing.T) {
+
+	// GIVEN
+	p := &lengthProblem{}
+	p.SetGenerator(generator.NewRandomGenerator(time.Now().UnixNano()))
+	p.SetDimensions(20)
+	p.SetAlphabet(4)
+	first := p.GenerateChromosome()
+	second := p.GenerateChromosome()
+
+	// WHEN
+	first.Generate()
+	second.Generate()
+
+	// THEN
+	if reflect.DeepEqual(first.GetPhenotype(), second.GetPhenotype()) {
+		t.Errorf("Expected every generated chromosome to have its own seed, Actual %v twice", first.GetPhenotype())
+	}
+}
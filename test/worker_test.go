@@ -0,0 +1,86 @@
+package test
+
+import (
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/opticverge/goevolution/examples/onemax"
+	"github.com/opticverge/goevolution/generator"
+	"github.com/opticverge/goevolution/solver"
+	"github.com/opticverge/goevolution/worker"
+)
+
+func TestPoolCapsConcurrency(t *testing.T) {
+
+	// GIVEN
+	workers := 3
+	pool := worker.NewPool(workers)
+	var running, peak int64
+
+	// WHEN
+	pool.Run(50, func(i int) {
+		current := atomic.AddInt64(&running, 1)
+		for {
+			previous := atomic.LoadInt64(&peak)
+			if current <= previous || atomic.CompareAndSwapInt64(&peak, previous, current) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		atomic.AddInt64(&running, -1)
+	})
+
+	// THEN
+	if peak > int64(workers) {
+		t.Errorf("Expected at most %v concurrent tasks, Actual %v", workers, peak)
+	}
+}
+
+func TestPoolNestedRunCompletes(t *testing.T) {
+
+	// GIVEN
+	pool := worker.NewPool(2)
+	var completed int64
+
+	// WHEN
+	pool.Run(10, func(i int) {
+		pool.Run(10, func(j int) {
+			atomic.AddInt64(&completed, 1)
+		})
+	})
+
+	// THEN
+	if completed != 100 {
+		t.Errorf("Expected %v nested tasks to complete, Actual %v", 100, completed)
+	}
+}
+
+func TestSolverWorkersDoNotChangeResult(t *testing.T) {
+
+	// GIVEN
+	seed := time.Now().UnixNano()
+	run := func(workers int) interface{} {
+		p := onemax.NewProblem()
+		p.SetGenerator(generator.NewRandomGenerator(seed))
+		p.SetDimensions(16)
+
+		s := solver.NewSolver()
+		s.SetEpochs(5)
+		s.SetProblem(p)
+		s.SetPopulationSize(10)
+		s.SetWorkers(workers)
+
+		return s.Run().GetPhenotype()
+	}
+
+	// WHEN
+	sequential := run(1)
+	parallel := run(8)
+
+	// THEN
+	if !reflect.DeepEqual(sequential, parallel) {
+		t.Errorf("Expected sequential and parallel runs of seed %v to match", seed)
+	}
+}
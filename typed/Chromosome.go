@@ -0,0 +1,70 @@
+package typed
+
+import (
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/generator"
+)
+
+// Chromosome adapts a genome of the type G to the IChromosome interface so
+// that it can be evolved by any solver. Its behaviour is delegated to the
+// generic problem which created it.
+type Chromosome[G any] struct {
+	chromosome.Chromosome
+	Genome     G
+	definition IProblem[G]
+}
+
+// Generate creates a new genome through the generic problem
+func (c *Chromosome[G]) Generate() {
+	c.Genome = c.definition.Generate(c.GetDimensions(), c.GetGenerator())
+}
+
+// Mutate mutates the genome through the generic problem
+func (c *Chromosome[G]) Mutate(mutationProbability float64) {
+	c.Genome = c.definition.Mutate(c.Genome, mutationProbability, c.GetGenerator())
+}
+
+// Clone creates a new copy of the chromosome
+func (c *Chromosome[G]) Clone(rng generator.IGenerator) chromosome.IChromosome {
+	return c.with(c.definition.Clone(c.Genome), rng)
+}
+
+// Crossover recombines the genomes through the generic problem. No children
+// are produced when the problem does not implement ICrossover.
+func (c *Chromosome[G]) Crossover(other chromosome.IChromosome, rng generator.IGenerator) []chromosome.IChromosome {
+
+	crossover, ok := c.definition.(ICrossover[G])
+	if !ok {
+		return nil
+	}
+
+	genomes := crossover.Crossover(c.Genome, other.(*Chromosome[G]).Genome, rng)
+	children := make([]chromosome.IChromosome, len(genomes))
+	for i, genome := range genomes {
+		children[i] = c.with(genome, rng)
+	}
+
+	return children
+}
+
+// GetPhenotype returns the genome of the chromosome
+func (c *Chromosome[G]) GetPhenotype() interface{} {
+	return c.Genome
+}
+
+// with creates a chromosome of the same problem and dimensions holding the
+// genome
+func (c *Chromosome[G]) with(genome G, rng generator.IGenerator) *Chromosome[G] {
+	chr := NewChromosome(c.definition, c.GetDimensions(), rng)
+	chr.Genome = genome
+	return chr
+}
+
+// NewChromosome creates a new instance of a Chromosome for the generic
+// problem
+func NewChromosome[G any](definition IProblem[G], dimensions int, rng generator.IGenerator) *Chromosome[G] {
+	chr := &Chromosome[G]{definition: definition}
+	chr.SetGenerator(rng)
+	chr.SetDimensions(dimensions)
+	return chr
+}
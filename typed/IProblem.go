@@ -0,0 +1,23 @@
+package typed
+
+import (
+	"context"
+
+	"github.com/opticverge/goevolution/generator"
+)
+
+// IProblem is the generic counterpart of problem.IProblem for problems whose
+// genome has the type G. The genome flows through generation, mutation,
+// cloning and evaluation without any type assertions in the problem code.
+type IProblem[G any] interface {
+	Generate(dimensions int, rng generator.IGenerator) G
+	Mutate(genome G, probability float64, rng generator.IGenerator) G
+	Clone(genome G) G
+	Evaluate(ctx context.Context, genome G) (float64, error)
+}
+
+// ICrossover is implemented by generic problems which are able to recombine
+// two genomes. The children returned must not share memory with the parents.
+type ICrossover[G any] interface {
+	Crossover(first G, second G, rng generator.IGenerator) []G
+}
@@ -0,0 +1,51 @@
+package typed
+
+import (
+	"context"
+
+	"github.com/opticverge/goevolution/chromosome"
+	"github.com/opticverge/goevolution/objective"
+	"github.com/opticverge/goevolution/problem"
+)
+
+// Problem adapts a generic problem to the IProblem interface. It embeds the
+// base Problem so the name, dimensions, objective and generator are set in
+// the same way as for any other problem.
+type Problem[G any] struct {
+	problem.Problem
+	definition IProblem[G]
+}
+
+// GetDefinition returns the generic problem
+func (p *Problem[G]) GetDefinition() IProblem[G] {
+	return p.definition
+}
+
+// Evaluate evaluates the genome of the chromosome through the generic problem
+func (p *Problem[G]) Evaluate(ctx context.Context, chromo chromosome.IChromosome) (float64, error) {
+	return p.definition.Evaluate(ctx, chromo.(*Chromosome[G]).Genome)
+}
+
+// ObjectiveFunction evaluates the chromosome and sets the fitness. Solvers
+// use Evaluate instead, so that errors are handled by their evaluation
+// policy, whereas a failure here panics.
+func (p *Problem[G]) ObjectiveFunction(chromo *chromosome.IChromosome) {
+	fitness, err := p.Evaluate(context.Background(), *chromo)
+	if err != nil {
+		panic(err)
+	}
+	(*chromo).SetFitness(fitness)
+}
+
+// GenerateChromosome creates a new Chromosome for the generic problem
+func (p *Problem[G]) GenerateChromosome() chromosome.IChromosome {
+	return NewChromosome(p.definition, p.GetDimensions(), p.DeriveGenerator())
+}
+
+// NewProblem creates a new instance of the Problem for the generic problem
+func NewProblem[G any](name string, obj objective.Objective, definition IProblem[G]) *Problem[G] {
+	p := &Problem[G]{definition: definition}
+	p.SetName(name)
+	p.SetObjective(obj)
+	return p
+}
@@ -0,0 +1,48 @@
+package typed
+
+import (
+	"context"
+
+	"github.com/opticverge/goevolution/solver"
+)
+
+// Solver runs an untyped solver on a generic problem and returns its
+// chromosomes with their genome type intact. Every setter and getter of the
+// untyped solver remains available through the embedded ISolver.
+type Solver[G any] struct {
+	solver.ISolver
+}
+
+// Run runs the solver and returns the best chromosome, or nil when the run
+// could not start
+func (s *Solver[G]) Run() *Chromosome[G] {
+	best, _ := s.RunContext(context.Background())
+	return best
+}
+
+// RunContext runs the solver until it terminates or the context is done
+func (s *Solver[G]) RunContext(ctx context.Context) (*Chromosome[G], error) {
+	best, err := s.ISolver.RunContext(ctx)
+	if best == nil {
+		return nil, err
+	}
+	return best.(*Chromosome[G]), err
+}
+
+// GetTypedPopulation returns the population of the solver
+func (s *Solver[G]) GetTypedPopulation() []*Chromosome[G] {
+	population := s.GetPopulation()
+	typed := make([]*Chromosome[G], len(population))
+	for i, c := range population {
+		typed[i] = c.(*Chromosome[G])
+	}
+	return typed
+}
+
+// NewSolver creates a new Solver for the generic problem using the default
+// untyped solver
+func NewSolver[G any](p *Problem[G]) *Solver[G] {
+	s := &Solver[G]{ISolver: solver.NewSolver()}
+	s.SetProblem(p)
+	return s
+}
@@ -0,0 +1,9 @@
+package worker
+
+// IPool represents the interface for a pool of workers which runs a number
+// of independent tasks and waits for them to complete. Tasks are identified
+// by their index.
+type IPool interface {
+	Run(int, func(int))
+	GetWorkers() int
+}
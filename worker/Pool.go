@@ -0,0 +1,75 @@
+package worker
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Pool runs tasks on at most a fixed number of goroutines. The goroutine
+// calling Run always works on the tasks itself and borrows additional
+// helpers from the pool while they are available. Since a caller never
+// waits for a helper to become free, tasks may call Run on the same pool
+// without deadlocking, which caps the parallelism of nested stages such as
+// evaluating the clones of a parent during mutation. A pool with a single
+// worker runs every task sequentially on the calling goroutine, which is
+// useful for debugging.
+type Pool struct {
+	workers int
+	helpers chan struct{}
+}
+
+// GetWorkers returns the maximum number of goroutines working on tasks
+func (p *Pool) GetWorkers() int {
+	return p.workers
+}
+
+// Run runs the task for every index from 0 to count and returns once all of
+// them have completed.
+func (p *Pool) Run(count int, task func(int)) {
+
+	next := int64(-1)
+
+	work := func() {
+		for {
+			i := int(atomic.AddInt64(&next, 1))
+			if i >= count {
+				return
+			}
+			task(i)
+		}
+	}
+
+	var wg sync.WaitGroup
+
+	// borrow as many helpers as are free, up to one per remaining task
+borrow:
+	for i := 1; i < count; i++ {
+		select {
+		case p.helpers <- struct{}{}:
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer func() { <-p.helpers }()
+				work()
+			}()
+		default:
+			break borrow
+		}
+	}
+
+	work()
+	wg.Wait()
+}
+
+// NewPool creates a new Pool with the given number of workers. When the
+// number of workers is zero or less it defaults to GOMAXPROCS.
+func NewPool(workers int) IPool {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	return &Pool{
+		workers: workers,
+		helpers: make(chan struct{}, workers-1),
+	}
+}